@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -48,6 +49,8 @@ func DataSourceBuckets() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceBucketsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"buckets": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -114,14 +117,18 @@ func dataSourceBucketsRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	tflog.Debug(ctx, "vnpaycloud_buckets data source read")
 
-	listResp := &dto.ListBucketsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Buckets(cfg.ProjectID), listResp, nil)
+	allBuckets, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Buckets(cfg.ProjectID), func(r *dto.ListBucketsResponse) []dto.S3Bucket { return r.Buckets })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_buckets: %s", err)
 	}
 
+	allBuckets, err = util.ApplyDataSourceFilters(d, allBuckets, "bucket_name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_buckets: %s", err)
+	}
+
 	var buckets []map[string]interface{}
-	for _, b := range listResp.Buckets {
+	for _, b := range allBuckets {
 		buckets = append(buckets, map[string]interface{}{
 			"bucket_name": b.BucketName,
 			"region":      b.Region,
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,10 +17,12 @@ func ResourceBucket() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceBucketCreate,
 		ReadContext:   resourceBucketRead,
+		UpdateContext: resourceBucketUpdate,
 		DeleteContext: resourceBucketDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: util.SetTagsDiff,
 		Schema: map[string]*schema.Schema{
 			"bucket_name": {
 				Type:        schema.TypeString,
@@ -53,6 +56,8 @@ func ResourceBucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     util.TagsSchema(),
+			"tags_all": util.TagsAllSchema(),
 		},
 	}
 }
@@ -65,6 +70,7 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		Region:           d.Get("region").(string),
 		StoragePolicyID:  d.Get("storage_policy_id").(string),
 		EnableObjectLock: d.Get("enable_object_lock").(bool),
+		Tags:             util.GetTagsAll(d, meta),
 	}
 
 	tflog.Debug(ctx, "vnpaycloud_bucket create", map[string]interface{}{"opts": fmt.Sprintf("%+v", createOpts)})
@@ -98,10 +104,28 @@ func resourceBucketRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("region", bucket.Region)
 	d.Set("created_at", bucket.CreatedAt)
 	d.Set("policy_name", bucket.PolicyName)
+	util.SetResourceTags(d, meta, bucket.Tags)
 
 	return nil
 }
 
+func resourceBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	bucketName := d.Id()
+
+	if d.HasChange("tags_all") {
+		tagsOpts := dto.UpdateTagsRequest{Tags: util.GetTagsAll(d, meta)}
+
+		tflog.Debug(ctx, "vnpaycloud_bucket update tags", map[string]interface{}{"bucket_name": bucketName, "tags_opts": tagsOpts})
+
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.BucketTags(cfg.ProjectID, bucketName), tagsOpts, nil, nil); err != nil {
+			return diag.Errorf("Error updating tags for vnpaycloud_bucket %s: %s", bucketName, err)
+		}
+	}
+
+	return resourceBucketRead(ctx, d, meta)
+}
+
 func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 	bucketName := d.Id()
@@ -119,13 +143,12 @@ func resourceBucketDelete(ctx context.Context, d *schema.ResourceData, meta inte
 
 // findBucketByName lists all buckets and finds one by name.
 func findBucketByName(ctx context.Context, cfg *config.Config, bucketName string) (*dto.S3Bucket, error) {
-	listResp := &dto.ListBucketsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Buckets(cfg.ProjectID), listResp, nil)
+	allBuckets, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Buckets(cfg.ProjectID), func(r *dto.ListBucketsResponse) []dto.S3Bucket { return r.Buckets })
 	if err != nil {
 		return nil, fmt.Errorf("error listing buckets: %s", err)
 	}
 
-	for _, b := range listResp.Buckets {
+	for _, b := range allBuckets {
 		if b.BucketName == bucketName {
 			return &b, nil
 		}
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,6 +16,8 @@ func DataSourceCertificates() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceCertificatesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"certificates": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -39,14 +42,18 @@ func DataSourceCertificates() *schema.Resource {
 func dataSourceCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListCertificatesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Certificates(cfg.ProjectID), resp, nil)
+	allCertificates, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Certificates(cfg.ProjectID), func(r *dto.ListCertificatesResponse) []dto.Certificate { return r.Certificates })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_certificates: %s", err)
 	}
 
-	out := make([]map[string]interface{}, 0, len(resp.Certificates))
-	for _, c := range resp.Certificates {
+	allCertificates, err = util.ApplyDataSourceFilters(d, allCertificates, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_certificates: %s", err)
+	}
+
+	out := make([]map[string]interface{}, 0, len(allCertificates))
+	for _, c := range allCertificates {
 		out = append(out, map[string]interface{}{
 			"id":                c.ID,
 			"name":              c.Name,
@@ -0,0 +1,176 @@
+package cloudinit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// MaxUserDataBytes is the largest user_data the backend accepts, measured
+// after base64 encoding.
+const MaxUserDataBytes = 65535
+
+// DataSourceCloudInitConfig renders a multipart MIME cloud-init document
+// from one or more parts, optionally gzip-compressed and base64-encoded.
+// Rendering is local; no API request is made.
+func DataSourceCloudInitConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudInitConfigRead,
+		Schema: map[string]*schema.Schema{
+			"part": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "A part of the multipart document, in order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "text/plain",
+							ValidateFunc: validation.StringIsNotEmpty,
+							Description:  "MIME type of the part, e.g. `text/cloud-config` or `text/x-shellscript`.",
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Body of the part.",
+						},
+						"filename": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Filename reported in the part's Content-Disposition header.",
+						},
+						"merge_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value of the part's X-Merge-Type header, controlling how cloud-init merges it with earlier parts.",
+						},
+					},
+				},
+			},
+			"gzip": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Compress the rendered document with gzip. Requires base64_encode.",
+			},
+			"base64_encode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Base64-encode the rendered document.",
+			},
+			"boundary": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MIMEBOUNDARY",
+				ValidateFunc: validation.StringLenBetween(1, 70),
+				Description:  "Boundary string separating the parts.",
+			},
+			"rendered": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The rendered document, ready for vnpaycloud_instance.user_data.",
+			},
+		},
+	}
+}
+
+func dataSourceCloudInitConfigRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	gzipOutput := d.Get("gzip").(bool)
+	base64Encode := d.Get("base64_encode").(bool)
+
+	if gzipOutput && !base64Encode {
+		return diag.Errorf("base64_encode must be true when gzip is true")
+	}
+
+	rendered, err := renderCloudInitConfig(d.Get("part").([]interface{}), d.Get("boundary").(string), gzipOutput, base64Encode)
+	if err != nil {
+		return diag.Errorf("Error rendering vnpaycloud_cloudinit_config: %s", err)
+	}
+
+	// The backend stores user_data base64-encoded, so that is what counts.
+	size := len(rendered)
+	if !base64Encode {
+		size = base64.StdEncoding.EncodedLen(size)
+	}
+	if size > MaxUserDataBytes {
+		return diag.Errorf("rendered vnpaycloud_cloudinit_config is %d bytes base64-encoded, larger than the %d byte user_data limit; enable gzip or shrink the parts", size, MaxUserDataBytes)
+	}
+
+	sum := sha256.Sum256([]byte(rendered))
+	d.SetId(hex.EncodeToString(sum[:]))
+	d.Set("rendered", rendered)
+
+	return nil
+}
+
+func renderCloudInitConfig(parts []interface{}, boundary string, gzipOutput, base64Encode bool) (string, error) {
+	var buf bytes.Buffer
+
+	mw := multipart.NewWriter(&buf)
+	if err := mw.SetBoundary(boundary); err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=\"%s\"\r\nMIME-Version: 1.0\r\n\r\n", boundary)
+
+	for i, raw := range parts {
+		part, ok := raw.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("part %d is empty", i)
+		}
+
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", part["content_type"].(string))
+		header.Set("MIME-Version", "1.0")
+		header.Set("Content-Transfer-Encoding", "7bit")
+		if filename := part["filename"].(string); filename != "" {
+			header.Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		}
+		if mergeType := part["merge_type"].(string); mergeType != "" {
+			header.Set("X-Merge-Type", mergeType)
+		}
+
+		w, err := mw.CreatePart(header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write([]byte(part["content"].(string))); err != nil {
+			return "", err
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+
+	out := buf.Bytes()
+	if gzipOutput {
+		var gz bytes.Buffer
+		zw := gzip.NewWriter(&gz)
+		if _, err := zw.Write(out); err != nil {
+			return "", err
+		}
+		if err := zw.Close(); err != nil {
+			return "", err
+		}
+		out = gz.Bytes()
+	}
+
+	if base64Encode {
+		return base64.StdEncoding.EncodeToString(out), nil
+	}
+	return string(out), nil
+}
@@ -0,0 +1,154 @@
+package cloudinit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceCloudInitConfigRead_Plain(t *testing.T) {
+	ds := DataSourceCloudInitConfig()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"gzip":          false,
+		"base64_encode": false,
+		"part": []interface{}{
+			map[string]interface{}{
+				"content_type": "text/cloud-config",
+				"content":      "packages:\n  - nginx\n",
+				"filename":     "init.cfg",
+			},
+			map[string]interface{}{
+				"content_type": "text/x-shellscript",
+				"content":      "#!/bin/sh\necho hello\n",
+				"merge_type":   "list(append)+dict(recurse_array)+str()",
+			},
+		},
+	})
+
+	diags := ds.ReadContext(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Id() == "" {
+		t.Error("expected ID to be set")
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(d.Get("rendered").(string)))
+	if err != nil {
+		t.Fatalf("failed to parse rendered document: %v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		t.Fatalf("failed to parse Content-Type: %v", err)
+	}
+	if mediaType != "multipart/mixed" || params["boundary"] != "MIMEBOUNDARY" {
+		t.Errorf("expected multipart/mixed with boundary MIMEBOUNDARY, got %s %v", mediaType, params)
+	}
+
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+
+	p, err := mr.NextPart()
+	if err != nil {
+		t.Fatalf("failed to read first part: %v", err)
+	}
+	if v := p.Header.Get("Content-Type"); v != "text/cloud-config" {
+		t.Errorf("expected first part text/cloud-config, got %s", v)
+	}
+	if v := p.FileName(); v != "init.cfg" {
+		t.Errorf("expected filename init.cfg, got %s", v)
+	}
+	if body, _ := io.ReadAll(p); string(body) != "packages:\n  - nginx\n" {
+		t.Errorf("unexpected first part body: %q", body)
+	}
+
+	p, err = mr.NextPart()
+	if err != nil {
+		t.Fatalf("failed to read second part: %v", err)
+	}
+	if v := p.Header.Get("X-Merge-Type"); v != "list(append)+dict(recurse_array)+str()" {
+		t.Errorf("unexpected X-Merge-Type %s", v)
+	}
+
+	if _, err := mr.NextPart(); err != io.EOF {
+		t.Errorf("expected exactly two parts, got err %v", err)
+	}
+}
+
+func TestDataSourceCloudInitConfigRead_GzipBase64(t *testing.T) {
+	ds := DataSourceCloudInitConfig()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"gzip":          true,
+		"base64_encode": true,
+		"part": []interface{}{
+			map[string]interface{}{
+				"content_type": "text/x-shellscript",
+				"content":      "#!/bin/sh\necho hello\n",
+			},
+		},
+	})
+
+	diags := ds.ReadContext(context.Background(), d, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	compressed, err := base64.StdEncoding.DecodeString(d.Get("rendered").(string))
+	if err != nil {
+		t.Fatalf("rendered is not base64: %v", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		t.Fatalf("rendered is not gzip: %v", err)
+	}
+	doc, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("failed to decompress: %v", err)
+	}
+	if !strings.Contains(string(doc), "echo hello") {
+		t.Errorf("expected decompressed document to contain the part, got %q", doc)
+	}
+}
+
+func TestDataSourceCloudInitConfigRead_GzipRequiresBase64(t *testing.T) {
+	ds := DataSourceCloudInitConfig()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"gzip":          true,
+		"base64_encode": false,
+		"part": []interface{}{
+			map[string]interface{}{"content": "#cloud-config\n"},
+		},
+	})
+
+	diags := ds.ReadContext(context.Background(), d, nil)
+	if !diags.HasError() {
+		t.Fatal("expected error for gzip without base64_encode, got none")
+	}
+}
+
+func TestDataSourceCloudInitConfigRead_SizeLimit(t *testing.T) {
+	ds := DataSourceCloudInitConfig()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"gzip":          false,
+		"base64_encode": true,
+		"part": []interface{}{
+			map[string]interface{}{"content": strings.Repeat("x", MaxUserDataBytes)},
+		},
+	})
+
+	diags := ds.ReadContext(context.Background(), d, nil)
+	if !diags.HasError() {
+		t.Fatal("expected size limit error, got none")
+	}
+	if !strings.Contains(diags[0].Summary, "user_data limit") {
+		t.Errorf("expected size limit error, got: %s", diags[0].Summary)
+	}
+}
@@ -7,7 +7,8 @@ import (
 
 type Config struct {
 	*mutexkv.MutexKV
-	Client    *client.Client
-	ProjectID string // Resolved from zone_id at provider init
-	ZoneID    string // User-provided zone_id
+	Client      *client.Client
+	ProjectID   string            // Resolved from zone_id at provider init
+	ZoneID      string            // User-provided zone_id
+	DefaultTags map[string]string // Provider-level default_tags, merged into every taggable resource
 }
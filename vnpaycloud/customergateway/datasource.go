@@ -122,14 +122,13 @@ func dataSourceCustomerGatewayRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("One of id or name must be specified for vnpaycloud_customer_gateway")
 	}
 
-	listResp := &dto.ListCustomerGatewaysResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.CustomerGateways(cfg.ProjectID), listResp, nil)
+	customerGateways, err := client.ListAll(ctx, cfg.Client, client.ApiPath.CustomerGateways(cfg.ProjectID), func(r *dto.ListCustomerGatewaysResponse) []dto.CustomerGateway { return r.CustomerGateways })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_customer_gateway: %s", err)
 	}
 
 	matches := make([]dto.CustomerGateway, 0, 1)
-	for _, cg := range listResp.CustomerGateways {
+	for _, cg := range customerGateways {
 		if cg.Name != nameFilter.(string) {
 			continue
 		}
@@ -181,6 +180,8 @@ func DataSourceCustomerGateways() *schema.Resource {
 		ReadContext: dataSourceCustomerGatewaysRead,
 		Description: "Use this data source to retrieve all VNPAY Cloud customer gateways in the current project.",
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"customer_gateways": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -275,14 +276,18 @@ func DataSourceCustomerGateways() *schema.Resource {
 func dataSourceCustomerGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListCustomerGatewaysResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.CustomerGateways(cfg.ProjectID), listResp, nil)
+	allCustomerGateways, err := client.ListAll(ctx, cfg.Client, client.ApiPath.CustomerGateways(cfg.ProjectID), func(r *dto.ListCustomerGatewaysResponse) []dto.CustomerGateway { return r.CustomerGateways })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_customer_gateways: %s", err)
 	}
 
+	allCustomerGateways, err = util.ApplyDataSourceFilters(d, allCustomerGateways, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_customer_gateways: %s", err)
+	}
+
 	var customerGateways []map[string]interface{}
-	for _, cg := range listResp.CustomerGateways {
+	for _, cg := range allCustomerGateways {
 		customerGateways = append(customerGateways, map[string]interface{}{
 			"id":               cg.ID,
 			"name":             cg.Name,
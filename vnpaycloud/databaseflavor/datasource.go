@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -55,13 +56,12 @@ func dataSourceDatabaseFlavorRead(ctx context.Context, d *schema.ResourceData, m
 	id := d.Get("id").(string)
 
 	// List all flavors and find by ID
-	resp := &dto.ListFlavorDatabasesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.DatabaseFlavors(cfg.ProjectID), resp, nil)
+	flavorDatabases, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabaseFlavors(cfg.ProjectID), func(r *dto.ListFlavorDatabasesResponse) []dto.FlavorDatabase { return r.FlavorDatabases })
 	if err != nil {
 		return diag.Errorf("Error listing database flavors: %s", err)
 	}
 
-	for _, f := range resp.FlavorDatabases {
+	for _, f := range flavorDatabases {
 		if f.ID == id {
 			d.SetId(f.ID)
 			d.Set("name", f.Name)
@@ -82,6 +82,8 @@ func DataSourceDatabaseFlavors() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceDatabaseFlavorsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"flavors": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -129,14 +131,18 @@ func DataSourceDatabaseFlavors() *schema.Resource {
 func dataSourceDatabaseFlavorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListFlavorDatabasesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.DatabaseFlavors(cfg.ProjectID), resp, nil)
+	flavorDatabases, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabaseFlavors(cfg.ProjectID), func(r *dto.ListFlavorDatabasesResponse) []dto.FlavorDatabase { return r.FlavorDatabases })
 	if err != nil {
 		return diag.Errorf("Error listing database flavors: %s", err)
 	}
 
+	flavorDatabases, err = util.ApplyDataSourceFilters(d, flavorDatabases, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_flavors: %s", err)
+	}
+
 	var flavors []map[string]interface{}
-	for _, f := range resp.FlavorDatabases {
+	for _, f := range flavorDatabases {
 		flavors = append(flavors, map[string]interface{}{
 			"id":        f.ID,
 			"name":      f.Name,
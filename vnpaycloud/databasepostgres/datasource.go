@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -127,6 +128,8 @@ func DataSourceDatabasePostgresInstances() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourcePostgresInstancesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"postgres_instances": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -178,14 +181,18 @@ func DataSourceDatabasePostgresInstances() *schema.Resource {
 func dataSourcePostgresInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListPostgresInstancesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.DatabasePostgresInstances(cfg.ProjectID), resp, nil)
+	postgresInstances, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabasePostgresInstances(cfg.ProjectID), func(r *dto.ListPostgresInstancesResponse) []dto.PostgresInstance { return r.PostgresInstances })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_database_postgres_instances: %s", err)
 	}
 
+	postgresInstances, err = util.ApplyDataSourceFilters(d, postgresInstances, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_postgres_instances: %s", err)
+	}
+
 	var instances []map[string]interface{}
-	for _, inst := range resp.PostgresInstances {
+	for _, inst := range postgresInstances {
 		instances = append(instances, map[string]interface{}{
 			"id":           inst.ID,
 			"name":         inst.Name,
@@ -44,7 +44,7 @@ func ResourceDatabasePostgresInstance() *schema.Resource {
 					return fmt.Errorf("tls_mode can only be set when enable_tls = true")
 				}
 			}
-			return nil
+			return util.SetTagsDiff(ctx, d, meta)
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -154,6 +154,8 @@ func ResourceDatabasePostgresInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     util.TagsSchema(),
+			"tags_all": util.TagsAllSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -174,6 +176,7 @@ func resourcePostgresInstanceCreate(ctx context.Context, d *schema.ResourceData,
 		Mode:             d.Get("mode").(string),
 		Replica:          d.Get("replica").(int),
 		ZoneID:           cfg.ZoneID,
+		Tags:             util.GetTagsAll(d, meta),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -282,6 +285,7 @@ func resourcePostgresInstanceRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("standby_port", inst.StandbyPort)
 	d.Set("status", inst.Status)
 	d.Set("created_at", inst.CreatedAt)
+	util.SetResourceTags(d, meta, inst.Tags)
 
 	return nil
 }
@@ -413,6 +417,14 @@ func resourcePostgresInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		opts := dto.UpdateTagsRequest{Tags: util.GetTagsAll(d, meta)}
+		_, err := cfg.Client.Put(ctx, client.ApiPath.DatabasePostgresInstanceTags(cfg.ProjectID, d.Id()), opts, nil, nil)
+		if err != nil {
+			return diag.Errorf("Error updating tags for vnpaycloud_database_postgres_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourcePostgresInstanceRead(ctx, d, meta)
 }
 
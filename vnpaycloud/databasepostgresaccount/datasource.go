@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -73,6 +74,8 @@ func DataSourceDatabasePostgresAccounts() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourcePostgresAccountsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"postgres_accounts": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -93,13 +96,18 @@ func DataSourceDatabasePostgresAccounts() *schema.Resource {
 func dataSourcePostgresAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListPostgresAccountsResponse{}
-	if _, err := cfg.Client.Get(ctx, client.ApiPath.DatabasePostgresAccounts(cfg.ProjectID), resp, nil); err != nil {
+	postgresAccounts, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabasePostgresAccounts(cfg.ProjectID), func(r *dto.ListPostgresAccountsResponse) []dto.PostgresAccount { return r.PostgresAccounts })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_database_postgres_accounts: %s", err)
 	}
 
+	postgresAccounts, err = util.ApplyDataSourceFilters(d, postgresAccounts, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_postgres_accounts: %s", err)
+	}
+
 	var accounts []map[string]interface{}
-	for _, acc := range resp.PostgresAccounts {
+	for _, acc := range postgresAccounts {
 		accounts = append(accounts, map[string]interface{}{
 			"id":                   acc.ID,
 			"name":                 acc.Name,
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -66,6 +67,8 @@ func DataSourceDatabasePostgresDatabases() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourcePostgresDatabasesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"postgres_databases": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -87,13 +90,18 @@ func DataSourceDatabasePostgresDatabases() *schema.Resource {
 func dataSourcePostgresDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListPostgresDatabasesResponse{}
-	if _, err := cfg.Client.Get(ctx, client.ApiPath.DatabasePostgresDatabases(cfg.ProjectID), resp, nil); err != nil {
+	postgresDatabases, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabasePostgresDatabases(cfg.ProjectID), func(r *dto.ListPostgresDatabasesResponse) []dto.PostgresDatabase { return r.PostgresDatabases })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_database_postgres_databases: %s", err)
 	}
 
+	postgresDatabases, err = util.ApplyDataSourceFilters(d, postgresDatabases, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_postgres_databases: %s", err)
+	}
+
 	var databases []map[string]interface{}
-	for _, db := range resp.PostgresDatabases {
+	for _, db := range postgresDatabases {
 		databases = append(databases, map[string]interface{}{
 			"id":                   db.ID,
 			"name":                 db.Name,
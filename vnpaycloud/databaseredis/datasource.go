@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -102,6 +103,8 @@ func DataSourceDatabaseRedisInstances() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRedisInstancesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"redis_instances": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -149,14 +152,18 @@ func DataSourceDatabaseRedisInstances() *schema.Resource {
 func dataSourceRedisInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListRedisInstancesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.DatabaseRedisInstances(cfg.ProjectID), resp, nil)
+	redisInstances, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabaseRedisInstances(cfg.ProjectID), func(r *dto.ListRedisInstancesResponse) []dto.RedisInstance { return r.RedisInstances })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_database_redis_instances: %s", err)
 	}
 
+	redisInstances, err = util.ApplyDataSourceFilters(d, redisInstances, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_redis_instances: %s", err)
+	}
+
 	var instances []map[string]interface{}
-	for _, inst := range resp.RedisInstances {
+	for _, inst := range redisInstances {
 		instances = append(instances, map[string]interface{}{
 			"id":           inst.ID,
 			"name":         inst.Name,
@@ -26,13 +26,12 @@ func ResourceDatabaseRedisInstance() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
-			if d.Get("enable_tls").(bool) {
-				return nil
-			}
-			if v, ok := d.GetOk("certificate_id"); ok && v.(string) != "" {
-				return fmt.Errorf("certificate_id can only be set when enable_tls = true")
+			if !d.Get("enable_tls").(bool) {
+				if v, ok := d.GetOk("certificate_id"); ok && v.(string) != "" {
+					return fmt.Errorf("certificate_id can only be set when enable_tls = true")
+				}
 			}
-			return nil
+			return util.SetTagsDiff(ctx, d, meta)
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -118,6 +117,8 @@ func ResourceDatabaseRedisInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     util.TagsSchema(),
+			"tags_all": util.TagsAllSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -137,6 +138,7 @@ func resourceRedisInstanceCreate(ctx context.Context, d *schema.ResourceData, me
 		VolumeSize:       int64(d.Get("volume_size").(int)),
 		Replica:          d.Get("replica").(int),
 		ZoneID:           cfg.ZoneID,
+		Tags:             util.GetTagsAll(d, meta),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -225,6 +227,7 @@ func resourceRedisInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("primary_port", inst.PrimaryPort)
 	d.Set("status", inst.Status)
 	d.Set("created_at", inst.CreatedAt)
+	util.SetResourceTags(d, meta, inst.Tags)
 
 	return nil
 }
@@ -325,6 +328,14 @@ func resourceRedisInstanceUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		opts := dto.UpdateTagsRequest{Tags: util.GetTagsAll(d, meta)}
+		_, err := cfg.Client.Put(ctx, client.ApiPath.DatabaseRedisInstanceTags(cfg.ProjectID, d.Id()), opts, nil, nil)
+		if err != nil {
+			return diag.Errorf("Error updating tags for vnpaycloud_database_redis_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceRedisInstanceRead(ctx, d, meta)
 }
 
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -66,6 +67,8 @@ func DataSourceDatabaseRedisAccounts() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRedisAccountsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"redis_accounts": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -87,13 +90,18 @@ func DataSourceDatabaseRedisAccounts() *schema.Resource {
 func dataSourceRedisAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListRedisAccountsResponse{}
-	if _, err := cfg.Client.Get(ctx, client.ApiPath.DatabaseRedisAccounts(cfg.ProjectID), resp, nil); err != nil {
+	redisAccounts, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabaseRedisAccounts(cfg.ProjectID), func(r *dto.ListRedisAccountsResponse) []dto.RedisAccount { return r.RedisAccounts })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_database_redis_accounts: %s", err)
 	}
 
+	redisAccounts, err = util.ApplyDataSourceFilters(d, redisAccounts, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_redis_accounts: %s", err)
+	}
+
 	var accounts []map[string]interface{}
-	for _, acc := range resp.RedisAccounts {
+	for _, acc := range redisAccounts {
 		accounts = append(accounts, map[string]interface{}{
 			"id":                 acc.ID,
 			"name":               acc.Name,
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -137,6 +138,8 @@ func DataSourceDatabaseRedisSentinelInstances() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRedisSentinelInstancesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"redis_sentinel_instances": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -188,14 +191,20 @@ func DataSourceDatabaseRedisSentinelInstances() *schema.Resource {
 func dataSourceRedisSentinelInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListRedisSentinelInstancesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.DatabaseRedisSentinelInstances(cfg.ProjectID), resp, nil)
+	redisSentinelInstances, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabaseRedisSentinelInstances(cfg.ProjectID), func(r *dto.ListRedisSentinelInstancesResponse) []dto.RedisSentinelInstance {
+		return r.RedisSentinelInstances
+	})
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_database_redis_sentinel_instances: %s", err)
 	}
 
+	redisSentinelInstances, err = util.ApplyDataSourceFilters(d, redisSentinelInstances, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_redis_sentinel_instances: %s", err)
+	}
+
 	var instances []map[string]interface{}
-	for _, inst := range resp.RedisSentinelInstances {
+	for _, inst := range redisSentinelInstances {
 		instances = append(instances, map[string]interface{}{
 			"id":               inst.ID,
 			"name":             inst.Name,
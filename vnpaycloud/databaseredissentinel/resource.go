@@ -26,13 +26,12 @@ func ResourceDatabaseRedisSentinelInstance() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
-			if d.Get("enable_tls").(bool) {
-				return nil
-			}
-			if v, ok := d.GetOk("certificate_id"); ok && v.(string) != "" {
-				return fmt.Errorf("certificate_id can only be set when enable_tls = true")
+			if !d.Get("enable_tls").(bool) {
+				if v, ok := d.GetOk("certificate_id"); ok && v.(string) != "" {
+					return fmt.Errorf("certificate_id can only be set when enable_tls = true")
+				}
 			}
-			return nil
+			return util.SetTagsDiff(ctx, d, meta)
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -157,6 +156,8 @@ func ResourceDatabaseRedisSentinelInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     util.TagsSchema(),
+			"tags_all": util.TagsAllSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -180,6 +181,7 @@ func resourceRedisSentinelInstanceCreate(ctx context.Context, d *schema.Resource
 		SentinelFlavorDatabaseID: d.Get("sentinel_flavor_database_id").(string),
 		SentinelVolumeSize:       int64(d.Get("sentinel_volume_size").(int)),
 		ZoneID:                   cfg.ZoneID,
+		Tags:                     util.GetTagsAll(d, meta),
 	}
 
 	if v, ok := d.GetOk("description"); ok {
@@ -286,6 +288,7 @@ func resourceRedisSentinelInstanceRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("standby_port", inst.StandbyPort)
 	d.Set("status", inst.Status)
 	d.Set("created_at", inst.CreatedAt)
+	util.SetResourceTags(d, meta, inst.Tags)
 
 	return nil
 }
@@ -440,6 +443,14 @@ func resourceRedisSentinelInstanceUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		opts := dto.UpdateTagsRequest{Tags: util.GetTagsAll(d, meta)}
+		_, err := cfg.Client.Put(ctx, client.ApiPath.DatabaseRedisSentinelInstanceTags(cfg.ProjectID, d.Id()), opts, nil, nil)
+		if err != nil {
+			return diag.Errorf("Error updating tags for vnpaycloud_database_redis_sentinel_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceRedisSentinelInstanceRead(ctx, d, meta)
 }
 
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -66,6 +67,8 @@ func DataSourceDatabaseRedisSentinelAccounts() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRedisSentinelAccountsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"redis_sentinel_accounts": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -87,13 +90,20 @@ func DataSourceDatabaseRedisSentinelAccounts() *schema.Resource {
 func dataSourceRedisSentinelAccountsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListRedisSentinelAccountsResponse{}
-	if _, err := cfg.Client.Get(ctx, client.ApiPath.DatabaseRedisSentinelAccounts(cfg.ProjectID), resp, nil); err != nil {
+	redisSentinelAccounts, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabaseRedisSentinelAccounts(cfg.ProjectID), func(r *dto.ListRedisSentinelAccountsResponse) []dto.RedisSentinelAccount {
+		return r.RedisSentinelAccounts
+	})
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_database_redis_sentinel_accounts: %s", err)
 	}
 
+	redisSentinelAccounts, err = util.ApplyDataSourceFilters(d, redisSentinelAccounts, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_redis_sentinel_accounts: %s", err)
+	}
+
 	var accounts []map[string]interface{}
-	for _, acc := range resp.RedisSentinelAccounts {
+	for _, acc := range redisSentinelAccounts {
 		accounts = append(accounts, map[string]interface{}{
 			"id":                         acc.ID,
 			"name":                       acc.Name,
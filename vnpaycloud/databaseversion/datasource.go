@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,8 @@ func DataSourceDatabasePostgresVersions() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourcePostgresVersionsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"versions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -26,13 +29,18 @@ func DataSourceDatabasePostgresVersions() *schema.Resource {
 func dataSourcePostgresVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListPostgresVersionsResponse{}
-	if _, err := cfg.Client.Get(ctx, client.ApiPath.DatabasePostgresVersions(cfg.ProjectID), resp, nil); err != nil {
+	allVersions, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabasePostgresVersions(cfg.ProjectID), func(r *dto.ListPostgresVersionsResponse) []string { return r.Versions })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_database_postgres_versions: %s", err)
 	}
 
+	allVersions, err = util.ApplyDataSourceFilters(d, allVersions, "value")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_postgres_versions: %s", err)
+	}
+
 	d.SetId("database_postgres_versions")
-	d.Set("versions", resp.Versions)
+	d.Set("versions", allVersions)
 
 	return nil
 }
@@ -41,6 +49,8 @@ func DataSourceDatabaseRedisVersions() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRedisVersionsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"versions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -53,13 +63,18 @@ func DataSourceDatabaseRedisVersions() *schema.Resource {
 func dataSourceRedisVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListRedisVersionsResponse{}
-	if _, err := cfg.Client.Get(ctx, client.ApiPath.DatabaseRedisVersions(cfg.ProjectID), resp, nil); err != nil {
+	allVersions, err := client.ListAll(ctx, cfg.Client, client.ApiPath.DatabaseRedisVersions(cfg.ProjectID), func(r *dto.ListRedisVersionsResponse) []string { return r.Versions })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_database_redis_versions: %s", err)
 	}
 
+	allVersions, err = util.ApplyDataSourceFilters(d, allVersions, "value")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_database_redis_versions: %s", err)
+	}
+
 	d.SetId("database_redis_versions")
-	d.Set("versions", resp.Versions)
+	d.Set("versions", allVersions)
 
 	return nil
 }
@@ -2,21 +2,23 @@ package dto
 
 // S3Bucket matches the backend S3Bucket proto message.
 type S3Bucket struct {
-	BucketName  string `json:"bucketName"`
-	Region      string `json:"region"`
-	CreatedAt   string `json:"createdAt"`
-	PolicyName  string `json:"policyName"`
-	SizeBytes   uint64 `json:"sizeBytes,string"`
-	ObjectCount uint64 `json:"objectCount,string"`
+	BucketName  string            `json:"bucketName"`
+	Region      string            `json:"region"`
+	CreatedAt   string            `json:"createdAt"`
+	PolicyName  string            `json:"policyName"`
+	SizeBytes   uint64            `json:"sizeBytes,string"`
+	ObjectCount uint64            `json:"objectCount,string"`
+	Tags        map[string]string `json:"tags,omitempty"`
 }
 
 // CreateBucketRequest matches the backend CreateBucketRequest proto message.
 // project_id is passed via URL path, not in the body.
 type CreateBucketRequest struct {
-	BucketName       string `json:"bucketName"`
-	Region           string `json:"region"`
-	StoragePolicyID  string `json:"storagePolicyId,omitempty"`
-	EnableObjectLock bool   `json:"enableObjectLock,omitempty"`
+	BucketName       string            `json:"bucketName"`
+	Region           string            `json:"region"`
+	StoragePolicyID  string            `json:"storagePolicyId,omitempty"`
+	EnableObjectLock bool              `json:"enableObjectLock,omitempty"`
+	Tags             map[string]string `json:"tags,omitempty"`
 }
 
 // ListBucketsResponse matches the backend ListBucketsResponse proto message.
@@ -3,52 +3,54 @@ package dto
 // --- Postgres Instance ---
 
 type PostgresInstance struct {
-	EnableReadOnlyEndpoint bool   `json:"enableReadOnlyEndpoint"`
-	ID                     string `json:"id"`
-	Name                   string `json:"name"`
-	Description            string `json:"description"`
-	DatabaseClusterID      string `json:"databaseClusterId"`
-	FlavorDatabaseID       string `json:"flavorDatabaseId"`
-	Version                string `json:"version"`
-	VolumeType             string `json:"volumeType"`
-	VolumeSize             int64  `json:"volumeSize,string"`
-	Mode                   string `json:"mode"`
-	PrimaryIP              string `json:"primaryIp"`
-	PrimaryPort            int    `json:"primaryPort"`
-	StandbyIP              string `json:"standbyIp"`
-	StandbyPort            int    `json:"standbyPort"`
-	ProjectName            string `json:"projectName"`
-	Replica                int    `json:"replica"`
-	Namespace              string `json:"namespace"`
-	Purpose                string `json:"purpose"`
-	IsAutoExpandVolume     bool   `json:"isAutoExpandVolume"`
-	UsageThreshold         int    `json:"usageThreshold"`
-	ScalePercent           int    `json:"scalePercent"`
-	EnableTls              bool   `json:"enableTls"`
-	CertificateID          string `json:"certificateId"`
-	TlsMode                string `json:"tlsMode"`
-	IsAttachedGateway      bool   `json:"isAttachedGateway"`
-	DataMsg                string `json:"dataMsg"`
-	ZoneID                 string `json:"zoneId"`
-	Status                 string `json:"status"`
-	CreatedAt              string `json:"createdAt"`
-	CustomerAdminUsername  string `json:"customerAdminUsername"`
+	EnableReadOnlyEndpoint bool              `json:"enableReadOnlyEndpoint"`
+	ID                     string            `json:"id"`
+	Name                   string            `json:"name"`
+	Description            string            `json:"description"`
+	DatabaseClusterID      string            `json:"databaseClusterId"`
+	FlavorDatabaseID       string            `json:"flavorDatabaseId"`
+	Version                string            `json:"version"`
+	VolumeType             string            `json:"volumeType"`
+	VolumeSize             int64             `json:"volumeSize,string"`
+	Mode                   string            `json:"mode"`
+	PrimaryIP              string            `json:"primaryIp"`
+	PrimaryPort            int               `json:"primaryPort"`
+	StandbyIP              string            `json:"standbyIp"`
+	StandbyPort            int               `json:"standbyPort"`
+	ProjectName            string            `json:"projectName"`
+	Replica                int               `json:"replica"`
+	Namespace              string            `json:"namespace"`
+	Purpose                string            `json:"purpose"`
+	IsAutoExpandVolume     bool              `json:"isAutoExpandVolume"`
+	UsageThreshold         int               `json:"usageThreshold"`
+	ScalePercent           int               `json:"scalePercent"`
+	EnableTls              bool              `json:"enableTls"`
+	CertificateID          string            `json:"certificateId"`
+	TlsMode                string            `json:"tlsMode"`
+	IsAttachedGateway      bool              `json:"isAttachedGateway"`
+	DataMsg                string            `json:"dataMsg"`
+	ZoneID                 string            `json:"zoneId"`
+	Status                 string            `json:"status"`
+	CreatedAt              string            `json:"createdAt"`
+	CustomerAdminUsername  string            `json:"customerAdminUsername"`
+	Tags                   map[string]string `json:"tags,omitempty"`
 }
 
 type CreatePostgresInstanceRequest struct {
-	Name             string `json:"name"`
-	Description      string `json:"description,omitempty"`
-	FlavorDatabaseID string `json:"flavorDatabaseId"`
-	Version          string `json:"version"`
-	VolumeType       string `json:"volumeType"`
-	VolumeSize       int64  `json:"volumeSize"`
-	Mode             string `json:"mode"`
-	Replica          int    `json:"replica"`
-	Purpose          string `json:"purpose,omitempty"`
-	EnableTls        bool   `json:"enableTls,omitempty"`
-	CertificateID    string `json:"certificateId,omitempty"`
-	TlsMode          string `json:"tlsMode,omitempty"`
-	ZoneID           string `json:"zoneId"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	FlavorDatabaseID string            `json:"flavorDatabaseId"`
+	Version          string            `json:"version"`
+	VolumeType       string            `json:"volumeType"`
+	VolumeSize       int64             `json:"volumeSize"`
+	Mode             string            `json:"mode"`
+	Replica          int               `json:"replica"`
+	Purpose          string            `json:"purpose,omitempty"`
+	EnableTls        bool              `json:"enableTls,omitempty"`
+	CertificateID    string            `json:"certificateId,omitempty"`
+	TlsMode          string            `json:"tlsMode,omitempty"`
+	ZoneID           string            `json:"zoneId"`
+	Tags             map[string]string `json:"tags,omitempty"`
 }
 
 type PostgresInstanceResponse struct {
@@ -248,46 +250,48 @@ type ListRedisVersionsResponse struct {
 // --- Redis Instance ---
 
 type RedisInstance struct {
-	ID                    string `json:"id"`
-	Name                  string `json:"name"`
-	Description           string `json:"description"`
-	DatabaseClusterID     string `json:"databaseClusterId"`
-	FlavorDatabaseID      string `json:"flavorDatabaseId"`
-	Version               string `json:"version"`
-	VolumeType            string `json:"volumeType"`
-	VolumeSize            int64  `json:"volumeSize,string"`
-	Mode                  string `json:"mode"`
-	PrimaryIP             string `json:"primaryIp"`
-	PrimaryPort           int    `json:"primaryPort"`
-	ProjectName           string `json:"projectName"`
-	Replica               int    `json:"replica"`
-	Namespace             string `json:"namespace"`
-	Purpose               string `json:"purpose"`
-	IsAutoExpandVolume    bool   `json:"isAutoExpandVolume"`
-	UsageThreshold        int    `json:"usageThreshold"`
-	ScalePercent          int    `json:"scalePercent"`
-	EnableTls             bool   `json:"enableTls"`
-	CertificateID         string `json:"certificateId"`
-	IsAttachedGateway     bool   `json:"isAttachedGateway"`
-	DataMsg               string `json:"dataMsg"`
-	ZoneID                string `json:"zoneId"`
-	Status                string `json:"status"`
-	CreatedAt             string `json:"createdAt"`
-	CustomerAdminUsername string `json:"customerAdminUsername"`
+	ID                    string            `json:"id"`
+	Name                  string            `json:"name"`
+	Description           string            `json:"description"`
+	DatabaseClusterID     string            `json:"databaseClusterId"`
+	FlavorDatabaseID      string            `json:"flavorDatabaseId"`
+	Version               string            `json:"version"`
+	VolumeType            string            `json:"volumeType"`
+	VolumeSize            int64             `json:"volumeSize,string"`
+	Mode                  string            `json:"mode"`
+	PrimaryIP             string            `json:"primaryIp"`
+	PrimaryPort           int               `json:"primaryPort"`
+	ProjectName           string            `json:"projectName"`
+	Replica               int               `json:"replica"`
+	Namespace             string            `json:"namespace"`
+	Purpose               string            `json:"purpose"`
+	IsAutoExpandVolume    bool              `json:"isAutoExpandVolume"`
+	UsageThreshold        int               `json:"usageThreshold"`
+	ScalePercent          int               `json:"scalePercent"`
+	EnableTls             bool              `json:"enableTls"`
+	CertificateID         string            `json:"certificateId"`
+	IsAttachedGateway     bool              `json:"isAttachedGateway"`
+	DataMsg               string            `json:"dataMsg"`
+	ZoneID                string            `json:"zoneId"`
+	Status                string            `json:"status"`
+	CreatedAt             string            `json:"createdAt"`
+	CustomerAdminUsername string            `json:"customerAdminUsername"`
+	Tags                  map[string]string `json:"tags,omitempty"`
 }
 
 type CreateRedisInstanceRequest struct {
-	Name             string `json:"name"`
-	Description      string `json:"description,omitempty"`
-	FlavorDatabaseID string `json:"flavorDatabaseId"`
-	Version          string `json:"version"`
-	VolumeType       string `json:"volumeType"`
-	VolumeSize       int64  `json:"volumeSize"`
-	Replica          int    `json:"replica"`
-	Purpose          string `json:"purpose,omitempty"`
-	EnableTls        bool   `json:"enableTls,omitempty"`
-	CertificateID    string `json:"certificateId,omitempty"`
-	ZoneID           string `json:"zoneId"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	FlavorDatabaseID string            `json:"flavorDatabaseId"`
+	Version          string            `json:"version"`
+	VolumeType       string            `json:"volumeType"`
+	VolumeSize       int64             `json:"volumeSize"`
+	Replica          int               `json:"replica"`
+	Purpose          string            `json:"purpose,omitempty"`
+	EnableTls        bool              `json:"enableTls,omitempty"`
+	CertificateID    string            `json:"certificateId,omitempty"`
+	ZoneID           string            `json:"zoneId"`
+	Tags             map[string]string `json:"tags,omitempty"`
 }
 
 type RedisInstanceResponse struct {
@@ -319,56 +323,58 @@ type EnableTlsRedisInstanceRequest struct {
 // --- Redis Sentinel Instance ---
 
 type RedisSentinelInstance struct {
-	EnableReadOnlyEndpoint   bool   `json:"enableReadOnlyEndpoint"`
-	ID                       string `json:"id"`
-	Name                     string `json:"name"`
-	Description              string `json:"description"`
-	DatabaseClusterID        string `json:"databaseClusterId"`
-	FlavorDatabaseID         string `json:"flavorDatabaseId"`
-	Version                  string `json:"version"`
-	VolumeType               string `json:"volumeType"`
-	VolumeSize               int64  `json:"volumeSize,string"`
-	PrimaryIP                string `json:"primaryIp"`
-	PrimaryPort              int    `json:"primaryPort"`
-	StandbyIP                string `json:"standbyIp"`
-	StandbyPort              int    `json:"standbyPort"`
-	ProjectName              string `json:"projectName"`
-	Replica                  int    `json:"replica"`
-	Namespace                string `json:"namespace"`
-	Purpose                  string `json:"purpose"`
-	IsAutoExpandVolume       bool   `json:"isAutoExpandVolume"`
-	UsageThreshold           int    `json:"usageThreshold"`
-	ScalePercent             int    `json:"scalePercent"`
-	SentinelName             string `json:"sentinelName"`
-	SentinelReplica          int    `json:"sentinelReplica"`
-	SentinelFlavorDatabaseID string `json:"sentinelFlavorDatabaseId"`
-	SentinelVolumeSize       int64  `json:"sentinelVolumeSize,string"`
-	EnableTls                bool   `json:"enableTls"`
-	CertificateID            string `json:"certificateId"`
-	IsAttachedGateway        bool   `json:"isAttachedGateway"`
-	DataMsg                  string `json:"dataMsg"`
-	ZoneID                   string `json:"zoneId"`
-	Status                   string `json:"status"`
-	CreatedAt                string `json:"createdAt"`
-	CustomerAdminUsername    string `json:"customerAdminUsername"`
+	EnableReadOnlyEndpoint   bool              `json:"enableReadOnlyEndpoint"`
+	ID                       string            `json:"id"`
+	Name                     string            `json:"name"`
+	Description              string            `json:"description"`
+	DatabaseClusterID        string            `json:"databaseClusterId"`
+	FlavorDatabaseID         string            `json:"flavorDatabaseId"`
+	Version                  string            `json:"version"`
+	VolumeType               string            `json:"volumeType"`
+	VolumeSize               int64             `json:"volumeSize,string"`
+	PrimaryIP                string            `json:"primaryIp"`
+	PrimaryPort              int               `json:"primaryPort"`
+	StandbyIP                string            `json:"standbyIp"`
+	StandbyPort              int               `json:"standbyPort"`
+	ProjectName              string            `json:"projectName"`
+	Replica                  int               `json:"replica"`
+	Namespace                string            `json:"namespace"`
+	Purpose                  string            `json:"purpose"`
+	IsAutoExpandVolume       bool              `json:"isAutoExpandVolume"`
+	UsageThreshold           int               `json:"usageThreshold"`
+	ScalePercent             int               `json:"scalePercent"`
+	SentinelName             string            `json:"sentinelName"`
+	SentinelReplica          int               `json:"sentinelReplica"`
+	SentinelFlavorDatabaseID string            `json:"sentinelFlavorDatabaseId"`
+	SentinelVolumeSize       int64             `json:"sentinelVolumeSize,string"`
+	EnableTls                bool              `json:"enableTls"`
+	CertificateID            string            `json:"certificateId"`
+	IsAttachedGateway        bool              `json:"isAttachedGateway"`
+	DataMsg                  string            `json:"dataMsg"`
+	ZoneID                   string            `json:"zoneId"`
+	Status                   string            `json:"status"`
+	CreatedAt                string            `json:"createdAt"`
+	CustomerAdminUsername    string            `json:"customerAdminUsername"`
+	Tags                     map[string]string `json:"tags,omitempty"`
 }
 
 type CreateRedisSentinelInstanceRequest struct {
-	Name                     string `json:"name"`
-	Description              string `json:"description,omitempty"`
-	FlavorDatabaseID         string `json:"flavorDatabaseId"`
-	Version                  string `json:"version"`
-	VolumeType               string `json:"volumeType"`
-	VolumeSize               int64  `json:"volumeSize"`
-	Replica                  int    `json:"replica"`
-	Purpose                  string `json:"purpose,omitempty"`
-	SentinelName             string `json:"sentinelName"`
-	SentinelReplica          int    `json:"sentinelReplica"`
-	SentinelFlavorDatabaseID string `json:"sentinelFlavorDatabaseId"`
-	SentinelVolumeSize       int64  `json:"sentinelVolumeSize"`
-	EnableTls                bool   `json:"enableTls,omitempty"`
-	CertificateID            string `json:"certificateId,omitempty"`
-	ZoneID                   string `json:"zoneId"`
+	Name                     string            `json:"name"`
+	Description              string            `json:"description,omitempty"`
+	FlavorDatabaseID         string            `json:"flavorDatabaseId"`
+	Version                  string            `json:"version"`
+	VolumeType               string            `json:"volumeType"`
+	VolumeSize               int64             `json:"volumeSize"`
+	Replica                  int               `json:"replica"`
+	Purpose                  string            `json:"purpose,omitempty"`
+	SentinelName             string            `json:"sentinelName"`
+	SentinelReplica          int               `json:"sentinelReplica"`
+	SentinelFlavorDatabaseID string            `json:"sentinelFlavorDatabaseId"`
+	SentinelVolumeSize       int64             `json:"sentinelVolumeSize"`
+	EnableTls                bool              `json:"enableTls,omitempty"`
+	CertificateID            string            `json:"certificateId,omitempty"`
+	ZoneID                   string            `json:"zoneId"`
+	Tags                     map[string]string `json:"tags,omitempty"`
 }
 
 type RedisSentinelInstanceResponse struct {
@@ -2,13 +2,57 @@ package dto
 
 // Image matches the backend Image proto message.
 type Image struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	OsType    string `json:"osType"`
-	OsVersion string `json:"osVersion"`
-	MinDiskGB int32  `json:"minDiskGb"`
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	OsType     string `json:"osType"`
+	OsVersion  string `json:"osVersion"`
+	MinDiskGB  int32  `json:"minDiskGb"`
+	Status     string `json:"status"`
+	Visibility string `json:"visibility,omitempty"`
+	SizeBytes  int64  `json:"sizeBytes,omitempty"`
+	DiskFormat string `json:"diskFormat,omitempty"`
+	Checksum   string `json:"checksum,omitempty"` // SHA-256 of the image data
+	InstanceID string `json:"instanceId,omitempty"`
+	VolumeID   string `json:"volumeId,omitempty"`
+	CreatedAt  string `json:"createdAt,omitempty"`
+	Zone       string `json:"zone"`
+}
+
+// CreateImageRequest matches the backend CreateImageRequest proto message.
+// project_id is passed via URL path, not in the body.
+type CreateImageRequest struct {
+	Name       string `json:"name"`
+	InstanceID string `json:"instanceId,omitempty"`
+	VolumeID   string `json:"volumeId,omitempty"`
+	SourceURL  string `json:"sourceUrl,omitempty"`
+	DiskFormat string `json:"diskFormat,omitempty"`
+	OsType     string `json:"osType,omitempty"`
+	MinDiskGB  int32  `json:"minDiskGb,omitempty"`
+}
+
+// UpdateImageRequest matches the backend UpdateImageRequest proto message.
+// project_id and id are passed via URL path.
+type UpdateImageRequest struct {
+	Name      string `json:"name,omitempty"`
+	OsType    string `json:"osType,omitempty"`
+	MinDiskGB int32  `json:"minDiskGb,omitempty"`
+}
+
+// ImageMember matches the backend ImageMember proto message.
+type ImageMember struct {
+	ProjectID string `json:"projectId"`
 	Status    string `json:"status"`
-	Zone      string `json:"zone"`
+}
+
+// AddImageMemberRequest matches the backend AddImageMemberRequest proto message.
+// project_id and id are passed via URL path.
+type AddImageMemberRequest struct {
+	ProjectID string `json:"projectId"`
+}
+
+// ListImageMembersResponse matches the backend ListImageMembersResponse proto message.
+type ListImageMembersResponse struct {
+	Members []ImageMember `json:"members"`
 }
 
 // ImageResponse matches the backend ImageResponse proto message.
@@ -2,37 +2,42 @@ package dto
 
 // Instance matches the backend Instance proto message.
 type Instance struct {
-	ID                  string   `json:"id"`
-	Name                string   `json:"name"`
-	ImageName           string   `json:"imageName"`
-	ImageID             string   `json:"imageId"`
-	FlavorName          string   `json:"flavorName"`
-	VolumeIDs           []string `json:"volumeIds"`
-	Status              string   `json:"status"`
-	PowerState          string   `json:"powerState"`
-	NetworkInterfaceIDs []string `json:"networkInterfaceIds"`
-	KeyPairID           string   `json:"keyPairId"`
-	SecurityGroupIDs    []string `json:"securityGroupIds"`
-	ServerGroupID       string   `json:"serverGroupId"`
-	CreatedAt           string   `json:"createdAt"`
-	ProjectID           string   `json:"projectId"`
-	ZoneID              string   `json:"zoneId"`
+	ID                  string            `json:"id"`
+	Name                string            `json:"name"`
+	ImageName           string            `json:"imageName"`
+	ImageID             string            `json:"imageId"`
+	FlavorName          string            `json:"flavorName"`
+	VolumeIDs           []string          `json:"volumeIds"`
+	Status              string            `json:"status"`
+	PowerState          string            `json:"powerState"`
+	NetworkInterfaceIDs []string          `json:"networkInterfaceIds"`
+	KeyPairID           string            `json:"keyPairId"`
+	SecurityGroupIDs    []string          `json:"securityGroupIds"`
+	ServerGroupID       string            `json:"serverGroupId"`
+	Tags                map[string]string `json:"tags,omitempty"`
+	CreatedAt           string            `json:"createdAt"`
+	ProjectID           string            `json:"projectId"`
+	ZoneID              string            `json:"zoneId"`
 }
 
 // CreateInstanceRequest matches the backend CreateInstanceRequest proto message.
 // project_id is passed via URL path, not in the body.
 type CreateInstanceRequest struct {
-	Name                string   `json:"name"`
-	Image               string   `json:"image,omitempty"`
-	SnapshotID          string   `json:"snapshotId,omitempty"`
-	Flavor              string   `json:"flavor,omitempty"`
-	RootDiskGB          int32    `json:"rootDiskGb"`
-	RootDiskVolumeType  string   `json:"rootDiskVolumeType"`
-	KeyPair             string   `json:"keyPair,omitempty"`
-	NetworkInterfaceIDs []string `json:"networkInterfaceIds,omitempty"`
-	ServerGroupID       string   `json:"serverGroupId,omitempty"`
-	UserData            string   `json:"userData,omitempty"`
-	IsUserDataBase64    bool     `json:"isUserDataBase64,omitempty"`
+	Name                string            `json:"name"`
+	Image               string            `json:"image,omitempty"`
+	SnapshotID          string            `json:"snapshotId,omitempty"`
+	Flavor              string            `json:"flavor,omitempty"`
+	IsCustomFlavor      bool              `json:"isCustomFlavor,omitempty"`
+	CustomVCPUs         int32             `json:"customVcpus,omitempty"`
+	CustomRAMMB         int32             `json:"customRamMb,omitempty"`
+	RootDiskGB          int32             `json:"rootDiskGb"`
+	RootDiskVolumeType  string            `json:"rootDiskVolumeType"`
+	KeyPair             string            `json:"keyPair,omitempty"`
+	NetworkInterfaceIDs []string          `json:"networkInterfaceIds,omitempty"`
+	ServerGroupID       string            `json:"serverGroupId,omitempty"`
+	UserData            string            `json:"userData,omitempty"`
+	IsUserDataBase64    bool              `json:"isUserDataBase64,omitempty"`
+	Tags                map[string]string `json:"tags,omitempty"`
 }
 
 // UpdateInstanceRequest matches the backend UpdateInstanceRequest proto message.
@@ -51,6 +56,32 @@ type ResizeInstanceRequest struct {
 	CustomRAMMB    int32  `json:"customRamMb,omitempty"`
 }
 
+// RebootInstanceRequest matches the backend RebootInstanceRequest proto message.
+// project_id and id are passed via URL path.
+type RebootInstanceRequest struct {
+	Type string `json:"type,omitempty"` // SOFT or HARD
+}
+
+// RebuildInstanceRequest matches the backend RebuildInstanceRequest proto message.
+// project_id and id are passed via URL path.
+type RebuildInstanceRequest struct {
+	Image string `json:"image"`
+}
+
+// UpdateInstanceMetadataRequest matches the backend UpdateInstanceMetadataRequest proto message.
+// project_id and id are passed via URL path.
+type UpdateInstanceMetadataRequest struct {
+	UserData         *string `json:"userData,omitempty"`
+	IsUserDataBase64 bool    `json:"isUserDataBase64,omitempty"`
+	KeyPair          string  `json:"keyPair,omitempty"`
+}
+
+// MigrateInstanceRequest matches the backend MigrateInstanceRequest proto message.
+// project_id and id are passed via URL path.
+type MigrateInstanceRequest struct {
+	ServerGroupID string `json:"serverGroupId"`
+}
+
 // InstanceResponse matches the backend InstanceResponse proto message.
 type InstanceResponse struct {
 	Instance Instance `json:"instance"`
@@ -60,3 +91,29 @@ type InstanceResponse struct {
 type ListInstancesResponse struct {
 	Instances []Instance `json:"instances"`
 }
+
+// ListInstancesOpts are the query parameters the backend ListInstances
+// endpoint filters on.
+type ListInstancesOpts struct {
+	Name          string `q:"name"`
+	FlavorName    string `q:"flavorName"`
+	ImageID       string `q:"imageId"`
+	ServerGroupID string `q:"serverGroupId"`
+	ZoneID        string `q:"zoneId"`
+}
+
+// InstanceConsoleOutputResponse matches the backend GetConsoleOutputResponse proto message.
+type InstanceConsoleOutputResponse struct {
+	Output string `json:"output"`
+}
+
+// InstanceConsole matches the backend RemoteConsole proto message.
+type InstanceConsole struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// InstanceConsoleURLResponse matches the backend GetConsoleURLResponse proto message.
+type InstanceConsoleURLResponse struct {
+	Console InstanceConsole `json:"console"`
+}
@@ -23,11 +23,11 @@ type CreateL7RuleRequest struct {
 }
 
 type UpdateL7RuleRequest struct {
-	RuleType     string `json:"ruleType,omitempty"`
-	CompareType  string `json:"compareType,omitempty"`
-	Value        string `json:"value,omitempty"`
-	Key          string `json:"key"`
-	Invert       bool   `json:"invert"`
+	RuleType    string `json:"ruleType,omitempty"`
+	CompareType string `json:"compareType,omitempty"`
+	Value       string `json:"value,omitempty"`
+	Key         string `json:"key"`
+	Invert      bool   `json:"invert"`
 }
 
 type L7RuleResponse struct {
@@ -2,29 +2,31 @@ package dto
 
 // LoadBalancer matches the backend LoadBalancer proto message.
 type LoadBalancer struct {
-	ID                 string `json:"id"`
-	Name               string `json:"name"`
-	Description        string `json:"description"`
-	Flavor             string `json:"flavor"`
-	VipAddress         string `json:"vipAddress"`
-	VipPortID          string `json:"vipPortId"`
-	VipSubnetID        string `json:"vipSubnetId"`
-	Status             string `json:"status"`
-	ProvisioningStatus string `json:"provisioningStatus"`
-	OperatingStatus    string `json:"operatingStatus"`
-	CreatedAt          string `json:"createdAt"`
-	FloatingIPID       string `json:"floatingIpId"`
+	ID                 string            `json:"id"`
+	Name               string            `json:"name"`
+	Description        string            `json:"description"`
+	Flavor             string            `json:"flavor"`
+	VipAddress         string            `json:"vipAddress"`
+	VipPortID          string            `json:"vipPortId"`
+	VipSubnetID        string            `json:"vipSubnetId"`
+	Status             string            `json:"status"`
+	ProvisioningStatus string            `json:"provisioningStatus"`
+	OperatingStatus    string            `json:"operatingStatus"`
+	CreatedAt          string            `json:"createdAt"`
+	FloatingIPID       string            `json:"floatingIpId"`
+	Tags               map[string]string `json:"tags,omitempty"`
 }
 
 // CreateLoadBalancerRequest matches the backend CreateLoadBalancerRequest proto message.
 // project_id is passed via URL path.
 type CreateLoadBalancerRequest struct {
-	Name         string `json:"name"`
-	Description  string `json:"description,omitempty"`
-	SubnetID     string `json:"subnetId"`
-	Flavor       string `json:"flavor"`
-	External     bool   `json:"external"`
-	FloatingIPID string `json:"floatingIpId,omitempty"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description,omitempty"`
+	SubnetID     string            `json:"subnetId"`
+	Flavor       string            `json:"flavor"`
+	External     bool              `json:"external"`
+	FloatingIPID string            `json:"floatingIpId,omitempty"`
+	Tags         map[string]string `json:"tags,omitempty"`
 }
 
 // UpdateLoadBalancerRequest matches the backend UpdateLoadBalancerRequest proto message.
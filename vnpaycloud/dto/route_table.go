@@ -1,27 +1,64 @@
 package dto
 
 // RouteTable matches the backend RouteTable proto message.
+// Single-route entries set DestCIDR/TargetID/TargetType; multi-route tables
+// set Routes and SubnetIDs instead.
 type RouteTable struct {
-	ID         string `json:"id"`
-	VpcID      string `json:"vpcId"`
-	DestCIDR   string `json:"destCidr"`
-	TargetID   string `json:"targetId"`
-	TargetType string `json:"targetType"`
-	TargetName string `json:"targetName"`
-	Name       string `json:"name"`
-	Status     string `json:"status"`
-	CreatedAt  string `json:"createdAt"`
-	ProjectID  string `json:"projectId"`
-	ZoneID     string `json:"zoneId"`
+	ID         string   `json:"id"`
+	VpcID      string   `json:"vpcId"`
+	DestCIDR   string   `json:"destCidr"`
+	TargetID   string   `json:"targetId"`
+	TargetType string   `json:"targetType"`
+	TargetName string   `json:"targetName"`
+	Name       string   `json:"name"`
+	Routes     []Route  `json:"routes,omitempty"`
+	SubnetIDs  []string `json:"subnetIds,omitempty"`
+	Status     string   `json:"status"`
+	CreatedAt  string   `json:"createdAt"`
+	ProjectID  string   `json:"projectId"`
+	ZoneID     string   `json:"zoneId"`
+}
+
+// Route matches the backend Route proto message.
+type Route struct {
+	DestinationCIDR string `json:"destinationCidr"`
+	TargetType      string `json:"targetType"`
+	TargetID        string `json:"targetId"`
+	TargetName      string `json:"targetName,omitempty"`
 }
 
 // CreateRouteTableRequest matches the backend CreateRouteTableRequest proto message.
 // project_id is passed via URL path.
 type CreateRouteTableRequest struct {
-	VpcID      string `json:"vpcId"`
-	DestCIDR   string `json:"destCidr"`
-	TargetID   string `json:"targetId"`
-	TargetType string `json:"targetType"`
+	VpcID      string   `json:"vpcId"`
+	DestCIDR   string   `json:"destCidr,omitempty"`
+	TargetID   string   `json:"targetId,omitempty"`
+	TargetType string   `json:"targetType,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	Routes     []Route  `json:"routes,omitempty"`
+	SubnetIDs  []string `json:"subnetIds,omitempty"`
+}
+
+// UpdateRouteTableRequest matches the backend UpdateRouteTableRequest proto message.
+// project_id and id are passed via URL path.
+type UpdateRouteTableRequest struct {
+	Name       string `json:"name,omitempty"`
+	DestCIDR   string `json:"destCidr,omitempty"`
+	TargetID   string `json:"targetId,omitempty"`
+	TargetType string `json:"targetType,omitempty"`
+}
+
+// RouteTableRoutesRequest matches the backend AddRoutesRequest and
+// RemoveRoutesRequest proto messages. Adding a route for a destination that
+// already exists replaces its target in place.
+type RouteTableRoutesRequest struct {
+	Routes []Route `json:"routes"`
+}
+
+// RouteTableSubnetRequest matches the backend AssociateSubnetRequest and
+// DisassociateSubnetRequest proto messages.
+type RouteTableSubnetRequest struct {
+	SubnetID string `json:"subnetId"`
 }
 
 // RouteTableResponse matches the backend RouteTableResponse proto message.
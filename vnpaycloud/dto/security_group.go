@@ -10,6 +10,7 @@ type SecurityGroupRule struct {
 	PortRangeMin    int32  `json:"portRangeMin"`
 	PortRangeMax    int32  `json:"portRangeMax"`
 	RemoteIPPrefix  string `json:"remoteIpPrefix"`
+	RemoteGroupID   string `json:"remoteGroupId"`
 	Description     string `json:"description"`
 	ProjectID       string `json:"projectId"`
 	ZoneID          string `json:"zoneId"`
@@ -70,12 +71,15 @@ type CreateSecurityGroupRuleRequest struct {
 	PortRangeMin    int32  `json:"portRangeMin,omitempty"`
 	PortRangeMax    int32  `json:"portRangeMax,omitempty"`
 	RemoteIPPrefix  string `json:"remoteIpPrefix,omitempty"`
+	RemoteGroupID   string `json:"remoteGroupId,omitempty"`
 	Description     string `json:"description,omitempty"`
 }
 
+// UpdateSecurityGroupRuleRequest matches the backend UpdateSecurityGroupRuleRequest proto message.
+// The description is always sent so that it can be cleared.
 type UpdateSecurityGroupRuleRequest struct {
 	RemoteIPPrefix string `json:"remoteIpPrefix,omitempty"`
-	Description    string `json:"description,omitempty"`
+	Description    string `json:"description"`
 }
 
 // SecurityGroupRuleResponse matches the backend SecurityGroupRuleResponse proto message.
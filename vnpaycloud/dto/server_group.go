@@ -2,19 +2,35 @@ package dto
 
 // ServerGroup matches the backend ServerGroup proto message.
 type ServerGroup struct {
-	ID        string   `json:"id"`
-	Name      string   `json:"name"`
-	Policy    string   `json:"policy"`
-	MemberIDs []string `json:"memberIds"`
-	CreatedAt string   `json:"createdAt"`
-	ProjectID string   `json:"projectId"`
+	ID        string              `json:"id"`
+	Name      string              `json:"name"`
+	Policy    string              `json:"policy"`
+	Rules     ServerGroupRules    `json:"rules"`
+	MemberIDs []string            `json:"memberIds"`
+	Members   []ServerGroupMember `json:"members"`
+	CreatedAt string              `json:"createdAt"`
+	ProjectID string              `json:"projectId"`
+}
+
+// ServerGroupRules matches the backend ServerGroupRules proto message.
+type ServerGroupRules struct {
+	MaxServerPerHost int32 `json:"maxServerPerHost,omitempty"`
+}
+
+// ServerGroupMember matches the backend ServerGroupMember proto message.
+// HostID is an opaque per-project identifier of the hypervisor.
+type ServerGroupMember struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	HostID string `json:"hostId"`
 }
 
 // CreateServerGroupRequest matches the backend CreateServerGroupRequest proto message.
 // project_id is passed via URL path, not in the body.
 type CreateServerGroupRequest struct {
-	Name   string `json:"name"`
-	Policy string `json:"policy"`
+	Name   string            `json:"name"`
+	Policy string            `json:"policy"`
+	Rules  *ServerGroupRules `json:"rules,omitempty"`
 }
 
 // ServerGroupResponse matches the backend ServerGroupResponse proto message.
@@ -26,3 +42,14 @@ type ServerGroupResponse struct {
 type ListServerGroupsResponse struct {
 	ServerGroups []ServerGroup `json:"serverGroups"`
 }
+
+// ServerGroupPolicy matches the backend ServerGroupPolicy proto message.
+type ServerGroupPolicy struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// ListServerGroupPoliciesResponse matches the backend ListServerGroupPoliciesResponse proto message.
+type ListServerGroupPoliciesResponse struct {
+	Policies []ServerGroupPolicy `json:"policies"`
+}
@@ -2,15 +2,17 @@ package dto
 
 // Snapshot matches the backend Snapshot proto message.
 type Snapshot struct {
-	ID          string `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	VolumeID    string `json:"volumeId"`
-	SizeGB      int64  `json:"sizeGb,string"`
-	Status      string `json:"status"`
-	CreatedAt   string `json:"createdAt"`
-	ProjectID   string `json:"projectId"`
-	ZoneID      string `json:"zoneId"`
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	VolumeID         string `json:"volumeId"`
+	SourceSnapshotID string `json:"sourceSnapshotId"` // set on snapshots created by a copy
+	SnapshotPolicyID string `json:"snapshotPolicyId"` // set on snapshots taken by a snapshot policy
+	SizeGB           int64  `json:"sizeGb,string"`
+	Status           string `json:"status"`
+	CreatedAt        string `json:"createdAt"`
+	ProjectID        string `json:"projectId"`
+	ZoneID           string `json:"zoneId"`
 }
 
 // CreateSnapshotRequest matches the backend CreateSnapshotRequest proto message.
@@ -21,6 +23,15 @@ type CreateSnapshotRequest struct {
 	VolumeID    string `json:"volumeId"`
 }
 
+// CopySnapshotRequest matches the backend CopySnapshotRequest proto message.
+// project_id and id of the source snapshot are passed via URL path.
+type CopySnapshotRequest struct {
+	Name                 string `json:"name"`
+	Description          string `json:"description,omitempty"`
+	DestinationZoneID    string `json:"destinationZoneId,omitempty"`
+	DestinationProjectID string `json:"destinationProjectId,omitempty"`
+}
+
 // SnapshotResponse matches the backend SnapshotResponse proto message.
 type SnapshotResponse struct {
 	Snapshot Snapshot `json:"snapshot"`
@@ -0,0 +1,59 @@
+package dto
+
+// SnapshotPolicy matches the backend SnapshotPolicy proto message.
+type SnapshotPolicy struct {
+	ID                          string   `json:"id"`
+	Name                        string   `json:"name"`
+	Description                 string   `json:"description"`
+	Schedule                    string   `json:"schedule"` // five-field cron expression
+	Timezone                    string   `json:"timezone"`
+	RetentionCount              int32    `json:"retentionCount"`
+	SnapshotNameTemplate        string   `json:"snapshotNameTemplate"`
+	SnapshotDescriptionTemplate string   `json:"snapshotDescriptionTemplate"`
+	VolumeIDs                   []string `json:"volumeIds"`
+	Enabled                     bool     `json:"enabled"`
+	Status                      string   `json:"status"`
+	LastRunAt                   string   `json:"lastRunAt"`
+	NextRunAt                   string   `json:"nextRunAt"`
+	CreatedAt                   string   `json:"createdAt"`
+	ProjectID                   string   `json:"projectId"`
+}
+
+// CreateSnapshotPolicyRequest matches the backend CreateSnapshotPolicyRequest proto message.
+// project_id is passed via URL path, not in the body.
+type CreateSnapshotPolicyRequest struct {
+	Name                        string   `json:"name"`
+	Description                 string   `json:"description,omitempty"`
+	Schedule                    string   `json:"schedule"`
+	Timezone                    string   `json:"timezone,omitempty"`
+	RetentionCount              int32    `json:"retentionCount"`
+	SnapshotNameTemplate        string   `json:"snapshotNameTemplate,omitempty"`
+	SnapshotDescriptionTemplate string   `json:"snapshotDescriptionTemplate,omitempty"`
+	VolumeIDs                   []string `json:"volumeIds"`
+	Enabled                     bool     `json:"enabled"`
+}
+
+// UpdateSnapshotPolicyRequest matches the backend UpdateSnapshotPolicyRequest proto message.
+// project_id and id are passed via URL path. The update replaces every field,
+// including the full list of volumes.
+type UpdateSnapshotPolicyRequest struct {
+	Name                        string   `json:"name"`
+	Description                 string   `json:"description"`
+	Schedule                    string   `json:"schedule"`
+	Timezone                    string   `json:"timezone"`
+	RetentionCount              int32    `json:"retentionCount"`
+	SnapshotNameTemplate        string   `json:"snapshotNameTemplate"`
+	SnapshotDescriptionTemplate string   `json:"snapshotDescriptionTemplate"`
+	VolumeIDs                   []string `json:"volumeIds"`
+	Enabled                     bool     `json:"enabled"`
+}
+
+// SnapshotPolicyResponse matches the backend SnapshotPolicyResponse proto message.
+type SnapshotPolicyResponse struct {
+	SnapshotPolicy SnapshotPolicy `json:"snapshotPolicy"`
+}
+
+// ListSnapshotPoliciesResponse matches the backend ListSnapshotPoliciesResponse proto message.
+type ListSnapshotPoliciesResponse struct {
+	SnapshotPolicies []SnapshotPolicy `json:"snapshotPolicies"`
+}
@@ -1,21 +1,22 @@
 package dto
 
 type Subnet struct {
-	ID             string      `json:"id"`
-	Name           string      `json:"name"`
-	VpcID          string      `json:"vpcId"`
-	CIDR           string      `json:"cidr"`
-	GatewayIP      string      `json:"gatewayIp"`
-	EnableDHCP     bool        `json:"enableDhcp"`
-	EnableSnat     bool        `json:"enableSnat"`
-	ExternalIpID   string      `json:"externalIpId"`
-	UsedByK8S      bool        `json:"usedByK8s"`
-	DNSNameservers []string    `json:"dnsNameservers,omitempty"`
-	Routes         []HostRoute `json:"routes,omitempty"`
-	Status         string      `json:"status"`
-	CreatedAt      string      `json:"createdAt"`
-	ProjectID      string      `json:"projectId"`
-	ZoneID         string      `json:"zoneId"`
+	ID             string            `json:"id"`
+	Name           string            `json:"name"`
+	VpcID          string            `json:"vpcId"`
+	CIDR           string            `json:"cidr"`
+	GatewayIP      string            `json:"gatewayIp"`
+	EnableDHCP     bool              `json:"enableDhcp"`
+	EnableSnat     bool              `json:"enableSnat"`
+	ExternalIpID   string            `json:"externalIpId"`
+	UsedByK8S      bool              `json:"usedByK8s"`
+	DNSNameservers []string          `json:"dnsNameservers,omitempty"`
+	Routes         []HostRoute       `json:"routes,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
+	Status         string            `json:"status"`
+	CreatedAt      string            `json:"createdAt"`
+	ProjectID      string            `json:"projectId"`
+	ZoneID         string            `json:"zoneId"`
 }
 
 type HostRoute struct {
@@ -24,15 +25,16 @@ type HostRoute struct {
 }
 
 type CreateSubnetRequest struct {
-	Name           string   `json:"name"`
-	VpcID          string   `json:"vpcId"`
-	NetworkID      string   `json:"networkId,omitempty"`
-	CIDR           string   `json:"cidr,omitempty"`
-	GatewayIP      string   `json:"gatewayIp,omitempty"`
-	EnableDHCP     bool     `json:"enableDhcp"`
-	UsedByK8S      bool     `json:"usedByK8s"`
-	UsedBySI       bool     `json:"usedBySi,omitempty"`
-	DNSNameservers []string `json:"dnsNameservers,omitempty"`
+	Name           string            `json:"name"`
+	VpcID          string            `json:"vpcId"`
+	NetworkID      string            `json:"networkId,omitempty"`
+	CIDR           string            `json:"cidr,omitempty"`
+	GatewayIP      string            `json:"gatewayIp,omitempty"`
+	EnableDHCP     bool              `json:"enableDhcp"`
+	UsedByK8S      bool              `json:"usedByK8s"`
+	UsedBySI       bool              `json:"usedBySi,omitempty"`
+	DNSNameservers []string          `json:"dnsNameservers,omitempty"`
+	Tags           map[string]string `json:"tags,omitempty"`
 }
 
 type UpdateSubnetRequest struct {
@@ -52,6 +54,13 @@ type ListSubnetsResponse struct {
 	Subnets []Subnet `json:"subnets"`
 }
 
+// ListSubnetsOpts are the query parameters the backend ListSubnets endpoint
+// filters on.
+type ListSubnetsOpts struct {
+	Name  string `q:"name"`
+	VpcID string `q:"vpcId"`
+}
+
 // EnableSubnetSNATRequest matches the backend EnableSubnetSNATRequest proto message.
 type EnableSubnetSNATRequest struct {
 	FloatingIpID string `json:"floatingIpId"`
@@ -0,0 +1,7 @@
+package dto
+
+// UpdateTagsRequest replaces the full tag set of a taggable resource.
+// project_id and id are passed via URL path.
+type UpdateTagsRequest struct {
+	Tags map[string]string `json:"tags"`
+}
@@ -2,35 +2,38 @@ package dto
 
 // Volume matches the backend Volume proto message.
 type Volume struct {
-	ID                 string `json:"id"`
-	Name               string `json:"name"`
-	Description        string `json:"description"`
-	SizeGB             int64  `json:"sizeGb,string"`
-	VolumeType         string `json:"volumeType"`
-	Zone               string `json:"zone"`
-	Status             string `json:"status"`
-	IOPS               int32  `json:"iops"`
-	IsEncrypted        bool   `json:"isEncrypted"`
-	IsMultiattach      bool   `json:"isMultiattach"`
-	IsBootable         bool   `json:"isBootable"`
-	AttachedServerID   string `json:"attachedServerId"`
-	AttachedServerName string `json:"attachedServerName"`
-	CreatedAt          string `json:"createdAt"`
-	ProjectID          string `json:"projectId"`
-	ZoneID             string `json:"zoneId"`
+	ID                 string            `json:"id"`
+	Name               string            `json:"name"`
+	Description        string            `json:"description"`
+	SizeGB             int64             `json:"sizeGb,string"`
+	VolumeType         string            `json:"volumeType"`
+	Zone               string            `json:"zone"`
+	Status             string            `json:"status"`
+	IOPS               int32             `json:"iops"`
+	IsEncrypted        bool              `json:"isEncrypted"`
+	IsMultiattach      bool              `json:"isMultiattach"`
+	IsBootable         bool              `json:"isBootable"`
+	AttachedServerID   string            `json:"attachedServerId"`
+	AttachedServerName string            `json:"attachedServerName"`
+	Tags               map[string]string `json:"tags,omitempty"`
+	CreatedAt          string            `json:"createdAt"`
+	ProjectID          string            `json:"projectId"`
+	ZoneID             string            `json:"zoneId"`
 }
 
 // CreateVolumeRequest matches the backend CreateVolumeRequest proto message.
 // project_id is passed via URL path, not in the body.
 // zone is resolved server-side from project_id.
 type CreateVolumeRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	SizeGB      int64  `json:"sizeGb,string"`
-	VolumeType  string `json:"volumeType"`
-	Encrypt     bool   `json:"encrypt,omitempty"`
-	Multiattach bool   `json:"multiattach,omitempty"`
-	SnapshotID  string `json:"snapshotId,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	SizeGB      int64             `json:"sizeGb,string"`
+	VolumeType  string            `json:"volumeType"`
+	Encrypt     bool              `json:"encrypt,omitempty"`
+	Multiattach bool              `json:"multiattach,omitempty"`
+	SnapshotID  string            `json:"snapshotId,omitempty"`
+	IOPS        int32             `json:"iops,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
 }
 
 // UpdateVolumeRequest matches the backend UpdateVolumeRequest proto message.
@@ -46,6 +49,26 @@ type ResizeVolumeRequest struct {
 	SizeGB int64 `json:"sizeGb,string"`
 }
 
+// RetypeVolumeRequest matches the backend RetypeVolumeRequest proto message.
+// project_id and id are passed via URL path.
+type RetypeVolumeRequest struct {
+	VolumeType      string `json:"volumeType"`
+	MigrationPolicy string `json:"migrationPolicy,omitempty"` // never or on-demand
+	IOPS            int32  `json:"iops,omitempty"`
+}
+
+// ModifyVolumeIOPSRequest matches the backend ModifyVolumeIOPSRequest proto message.
+// project_id and id are passed via URL path.
+type ModifyVolumeIOPSRequest struct {
+	IOPS int32 `json:"iops"`
+}
+
+// RevertVolumeRequest matches the backend RevertVolumeRequest proto message.
+// project_id and id are passed via URL path.
+type RevertVolumeRequest struct {
+	SnapshotID string `json:"snapshotId"`
+}
+
 // VolumeResponse matches the backend VolumeResponse proto message.
 type VolumeResponse struct {
 	Volume Volume `json:"volume"`
@@ -55,3 +78,11 @@ type VolumeResponse struct {
 type ListVolumesResponse struct {
 	Volumes []Volume `json:"volumes"`
 }
+
+// ListVolumesOpts are the query parameters the backend ListVolumes endpoint
+// filters on.
+type ListVolumesOpts struct {
+	Name             string `q:"name"`
+	VolumeType       string `q:"volumeType"`
+	AttachedServerID string `q:"attachedServerId"`
+}
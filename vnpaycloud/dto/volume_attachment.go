@@ -16,6 +16,7 @@ type VolumeAttachment struct {
 // project_id and volume_id are passed via URL path.
 type AttachVolumeRequest struct {
 	ServerID string `json:"serverId"`
+	Device   string `json:"device,omitempty"` // requested device path, e.g. /dev/vdc
 }
 
 // DetachVolumeRequest matches the backend DetachVolumeRequest proto message.
@@ -0,0 +1,42 @@
+package dto
+
+// VolumeBackup matches the backend VolumeBackup proto message.
+type VolumeBackup struct {
+	ID                  string `json:"id"`
+	Name                string `json:"name"`
+	Description         string `json:"description"`
+	VolumeID            string `json:"volumeId"`
+	IsIncremental       bool   `json:"isIncremental"`
+	HasDependentBackups bool   `json:"hasDependentBackups"` // incremental backups are based on this one
+	SizeGB              int64  `json:"sizeGb,string"`
+	Status              string `json:"status"`
+	CreatedAt           string `json:"createdAt"`
+	ProjectID           string `json:"projectId"`
+	ZoneID              string `json:"zoneId"`
+}
+
+// CreateVolumeBackupRequest matches the backend CreateVolumeBackupRequest proto message.
+// project_id is passed via URL path, not in the body.
+type CreateVolumeBackupRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	VolumeID    string `json:"volumeId"`
+	Incremental bool   `json:"incremental"`
+	Force       bool   `json:"force,omitempty"` // back up a volume that is attached
+}
+
+// RestoreVolumeBackupRequest matches the backend RestoreVolumeBackupRequest proto message.
+// project_id and id are passed via URL path.
+type RestoreVolumeBackupRequest struct {
+	VolumeID string `json:"volumeId"`
+}
+
+// VolumeBackupResponse matches the backend VolumeBackupResponse proto message.
+type VolumeBackupResponse struct {
+	Backup VolumeBackup `json:"backup"`
+}
+
+// ListVolumeBackupsResponse matches the backend ListVolumeBackupsResponse proto message.
+type ListVolumeBackupsResponse struct {
+	Backups []VolumeBackup `json:"backups"`
+}
@@ -2,12 +2,15 @@ package dto
 
 // VolumeType matches the backend VolumeType proto message.
 type VolumeType struct {
-	ID            string `json:"id"`
-	Name          string `json:"name"`
-	IOPS          int32  `json:"iops"`
-	IsEncrypted   bool   `json:"isEncrypted"`
-	IsMultiattach bool   `json:"isMultiattach"`
-	Zone          string `json:"zone"`
+	ID                      string `json:"id"`
+	Name                    string `json:"name"`
+	IOPS                    int32  `json:"iops"`
+	SupportsProvisionedIOPS bool   `json:"supportsProvisionedIops"` // volumes may request iops in [MinIOPS, MaxIOPS]
+	MinIOPS                 int32  `json:"minIops"`
+	MaxIOPS                 int32  `json:"maxIops"`
+	IsEncrypted             bool   `json:"isEncrypted"`
+	IsMultiattach           bool   `json:"isMultiattach"`
+	Zone                    string `json:"zone"`
 }
 
 // VolumeTypeResponse matches the backend VolumeTypeResponse proto message.
@@ -2,25 +2,27 @@ package dto
 
 // VPC matches the backend VPC proto message.
 type VPC struct {
-	ID          string   `json:"id"`
-	Name        string   `json:"name"`
-	Description string   `json:"description"`
-	CIDR        string   `json:"cidr"`
-	Status      string   `json:"status"`
-	SubnetIDs   []string `json:"subnetIds"`
-	EnableSnat  bool     `json:"enableSnat"`
-	SnatAddress string   `json:"snatAddress"`
-	CreatedAt   string   `json:"createdAt"`
-	ProjectID   string   `json:"projectId"`
-	ZoneID      string   `json:"zoneId"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	CIDR        string            `json:"cidr"`
+	Status      string            `json:"status"`
+	SubnetIDs   []string          `json:"subnetIds"`
+	EnableSnat  bool              `json:"enableSnat"`
+	SnatAddress string            `json:"snatAddress"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	CreatedAt   string            `json:"createdAt"`
+	ProjectID   string            `json:"projectId"`
+	ZoneID      string            `json:"zoneId"`
 }
 
 // CreateVPCRequest matches the backend CreateVPCRequest proto message.
 // project_id is passed via URL path, not in the body.
 type CreateVPCRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description,omitempty"`
-	CIDR        string `json:"cidr,omitempty"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	CIDR        string            `json:"cidr,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
 }
 
 // UpdateVPCRequest matches the backend UpdateVPCRequest proto message.
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -61,15 +62,14 @@ func dataSourceFlavorRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return setFlavorData(d, &resp.Flavor)
 	}
 
-	listResp := &dto.ListFlavorsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Flavors(cfg.ZoneID), listResp, nil)
+	flavors, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Flavors(cfg.ZoneID), func(r *dto.ListFlavorsResponse) []dto.Flavor { return r.Flavors })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_flavor: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, f := range listResp.Flavors {
+	for _, f := range flavors {
 		if nameOk && f.Name != nameFilter.(string) {
 			continue
 		}
@@ -94,6 +94,8 @@ func DataSourceFlavors() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceFlavorsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"flavors": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -116,14 +118,18 @@ func DataSourceFlavors() *schema.Resource {
 func dataSourceFlavorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListFlavorsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Flavors(cfg.ZoneID), listResp, nil)
+	allFlavors, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Flavors(cfg.ZoneID), func(r *dto.ListFlavorsResponse) []dto.Flavor { return r.Flavors })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_flavors: %s", err)
 	}
 
+	allFlavors, err = util.ApplyDataSourceFilters(d, allFlavors, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_flavors: %s", err)
+	}
+
 	var flavors []map[string]interface{}
-	for _, f := range listResp.Flavors {
+	for _, f := range allFlavors {
 		flavors = append(flavors, map[string]interface{}{
 			"id":        f.ID,
 			"name":      f.Name,
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -62,15 +63,14 @@ func dataSourceFloatingIPRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// List and filter client-side
-	listResp := &dto.ListFloatingIPsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.FloatingIPs(cfg.ProjectID), listResp, nil)
+	floatingIPs, err := client.ListAll(ctx, cfg.Client, client.ApiPath.FloatingIPs(cfg.ProjectID), func(r *dto.ListFloatingIPsResponse) []dto.FloatingIP { return r.FloatingIPs })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_floating_ip: %s", err)
 	}
 
 	addressFilter, addressOk := d.GetOk("address")
 
-	for _, fip := range listResp.FloatingIPs {
+	for _, fip := range floatingIPs {
 		if addressOk && fip.Address != addressFilter.(string) {
 			continue
 		}
@@ -95,6 +95,8 @@ func DataSourceFloatingIPs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceFloatingIPsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"floating_ips": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -117,14 +119,18 @@ func DataSourceFloatingIPs() *schema.Resource {
 func dataSourceFloatingIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListFloatingIPsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.FloatingIPs(cfg.ProjectID), listResp, nil)
+	allFloatingIPs, err := client.ListAll(ctx, cfg.Client, client.ApiPath.FloatingIPs(cfg.ProjectID), func(r *dto.ListFloatingIPsResponse) []dto.FloatingIP { return r.FloatingIPs })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_floating_ips: %s", err)
 	}
 
+	allFloatingIPs, err = util.ApplyDataSourceFilters(d, allFloatingIPs, "address")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_floating_ips: %s", err)
+	}
+
 	var floatingIPs []map[string]interface{}
-	for _, fip := range listResp.FloatingIPs {
+	for _, fip := range allFloatingIPs {
 		floatingIPs = append(floatingIPs, map[string]interface{}{
 			"id":            fip.ID,
 			"address":       fip.Address,
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -97,6 +98,8 @@ func DataSourceHealthMonitors() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceHealthMonitorsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"health_monitors": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -124,14 +127,18 @@ func DataSourceHealthMonitors() *schema.Resource {
 func dataSourceHealthMonitorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListHealthMonitorsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.HealthMonitors(cfg.ProjectID), resp, nil)
+	healthMonitors, err := client.ListAll(ctx, cfg.Client, client.ApiPath.HealthMonitors(cfg.ProjectID), func(r *dto.ListHealthMonitorsResponse) []dto.HealthMonitor { return r.HealthMonitors })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_lb_health_monitors: %s", err)
 	}
 
+	healthMonitors, err = util.ApplyDataSourceFilters(d, healthMonitors, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_lb_health_monitors: %s", err)
+	}
+
 	var monitors []map[string]interface{}
-	for _, m := range resp.HealthMonitors {
+	for _, m := range healthMonitors {
 		monitors = append(monitors, map[string]interface{}{
 			"id":               m.ID,
 			"name":             m.Name,
@@ -11,6 +11,7 @@ var ApiPath = struct {
 	VPCs       func(projectID string) string
 	VPCWithID  func(projectID, id string) string
 	VPCSetSNAT func(projectID, id string) string
+	VPCTags    func(projectID, id string) string
 
 	// Subnet
 	Subnets           func(projectID string) string
@@ -18,6 +19,7 @@ var ApiPath = struct {
 	SubnetRoutes      func(projectID, id string) string
 	SubnetEnableSNAT  func(projectID, id string) string
 	SubnetDisableSNAT func(projectID, id string) string
+	SubnetTags        func(projectID, id string) string
 
 	// Security Group
 	SecurityGroups      func(projectID string) string
@@ -56,24 +58,38 @@ var ApiPath = struct {
 	NetworkInterfaceSecurityGroups      func(projectID, id string) string
 
 	// Volume
-	Volumes      func(projectID string) string
-	VolumeWithID func(projectID, id string) string
-	VolumeResize func(projectID, id string) string
-	VolumeAttach func(projectID, id string) string
-	VolumeDetach func(projectID, id string) string
+	Volumes          func(projectID string) string
+	VolumeWithID     func(projectID, id string) string
+	VolumeResize     func(projectID, id string) string
+	VolumeRetype     func(projectID, id string) string
+	VolumeModifyIOPS func(projectID, id string) string
+	VolumeRevert     func(projectID, id string) string
+	VolumeAttach     func(projectID, id string) string
+	VolumeDetach     func(projectID, id string) string
+	VolumeTags       func(projectID, id string) string
 
 	// Volume Attachment
 	VolumeAttachments      func(projectID string) string
 	VolumeAttachmentWithID func(projectID, id string) string
 
 	// Instance
-	Instances      func(projectID string) string
-	InstanceWithID func(projectID, id string) string
-	InstanceResize func(projectID, id string) string
+	Instances             func(projectID string) string
+	InstanceWithID        func(projectID, id string) string
+	InstanceResize        func(projectID, id string) string
+	InstanceTags          func(projectID, id string) string
+	InstanceStart         func(projectID, id string) string
+	InstanceStop          func(projectID, id string) string
+	InstanceReboot        func(projectID, id string) string
+	InstanceRebuild       func(projectID, id string) string
+	InstanceConsoleOutput func(projectID, id string) string
+	InstanceConsoleURL    func(projectID, id string) string
+	InstanceMetadata      func(projectID, id string) string
+	InstanceMigrate       func(projectID, id string) string
 
 	// Server Group
-	ServerGroups      func(projectID string) string
-	ServerGroupWithID func(projectID, id string) string
+	ServerGroups        func(projectID string) string
+	ServerGroupWithID   func(projectID, id string) string
+	ServerGroupPolicies func(projectID string) string
 
 	// KeyPair (global resource — uses name, not ID)
 	CreateKeyPair   func() string
@@ -104,11 +120,22 @@ var ApiPath = struct {
 	// Snapshot
 	Snapshots      func(projectID string) string
 	SnapshotWithID func(projectID, id string) string
+	SnapshotCopy   func(projectID, id string) string
+
+	// Snapshot Policy
+	SnapshotPolicies     func(projectID string) string
+	SnapshotPolicyWithID func(projectID, id string) string
+
+	// Volume Backup
+	VolumeBackups       func(projectID string) string
+	VolumeBackupWithID  func(projectID, id string) string
+	VolumeBackupRestore func(projectID, id string) string
 
 	// Load Balancer
 	LoadBalancers            func(projectID string) string
 	LoadBalancerWithID       func(projectID, id string) string
 	LoadBalancerChangeFlavor func(projectID, id string) string
+	LoadBalancerTags         func(projectID, id string) string
 	LBFlavors                func(projectID string) string
 
 	// Certificate (shared — not LB-specific)
@@ -155,8 +182,12 @@ var ApiPath = struct {
 	WorkerGroupWithID func(projectID, clusterID, id string) string
 
 	// Route Table
-	RouteTables      func(projectID string) string
-	RouteTableWithID func(projectID, id string) string
+	RouteTables                  func(projectID string) string
+	RouteTableWithID             func(projectID, id string) string
+	RouteTableAddRoutes          func(projectID, id string) string
+	RouteTableRemoveRoutes       func(projectID, id string) string
+	RouteTableAssociateSubnet    func(projectID, id string) string
+	RouteTableDisassociateSubnet func(projectID, id string) string
 
 	// Private Gateway
 	PrivateGateways      func(projectID string) string
@@ -193,6 +224,13 @@ var ApiPath = struct {
 	Images      func(zone string) string
 	ImageWithID func(id string) string
 
+	// Project Image (private images owned by the project)
+	ProjectImages            func(projectID string) string
+	ProjectImageWithID       func(projectID, id string) string
+	ProjectImageFile         func(projectID, id string) string
+	ProjectImageMembers      func(projectID, id string) string
+	ProjectImageMemberWithID func(projectID, id, memberProjectID string) string
+
 	// Volume Type (not project-scoped, filtered by zone)
 	VolumeTypes      func(zone string) string
 	VolumeTypeWithID func(id string) string
@@ -201,6 +239,7 @@ var ApiPath = struct {
 	Buckets      func(projectID string) string
 	BucketUsage  func(projectID, bucketName string) string
 	BucketDelete func(projectID, bucketName, region string) string
+	BucketTags   func(projectID, bucketName string) string
 
 	// Database Postgres Instance
 	DatabasePostgresInstances                       func(projectID string) string
@@ -212,6 +251,7 @@ var ApiPath = struct {
 	DatabasePostgresInstanceDisableAutoExpandVolume func(projectID, id string) string
 	DatabasePostgresInstanceEnableTls               func(projectID, id string) string
 	DatabasePostgresInstanceDisableTls              func(projectID, id string) string
+	DatabasePostgresInstanceTags                    func(projectID, id string) string
 
 	// Database Redis Instance
 	DatabaseRedisInstances                       func(projectID string) string
@@ -222,6 +262,7 @@ var ApiPath = struct {
 	DatabaseRedisInstanceDisableAutoExpandVolume func(projectID, id string) string
 	DatabaseRedisInstanceEnableTls               func(projectID, id string) string
 	DatabaseRedisInstanceDisableTls              func(projectID, id string) string
+	DatabaseRedisInstanceTags                    func(projectID, id string) string
 
 	// Database Redis Sentinel Instance
 	DatabaseRedisSentinelInstances                       func(projectID string) string
@@ -235,6 +276,7 @@ var ApiPath = struct {
 	DatabaseRedisSentinelInstanceSentinelChangeFlavor    func(projectID, id string) string
 	DatabaseRedisSentinelInstanceEnableTls               func(projectID, id string) string
 	DatabaseRedisSentinelInstanceDisableTls              func(projectID, id string) string
+	DatabaseRedisSentinelInstanceTags                    func(projectID, id string) string
 
 	// Database Flavor
 	DatabaseFlavors func(projectID string) string
@@ -285,6 +327,9 @@ var ApiPath = struct {
 	VPCSetSNAT: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/vpcs/%s/snat", projectID, id)
 	},
+	VPCTags: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/vpcs/%s/tags", projectID, id)
+	},
 	Subnets: func(projectID string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/subnets", projectID)
 	},
@@ -300,6 +345,9 @@ var ApiPath = struct {
 	SubnetDisableSNAT: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/subnets/%s/disable-snat", projectID, id)
 	},
+	SubnetTags: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/subnets/%s/tags", projectID, id)
+	},
 	SecurityGroups: func(projectID string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/security-groups", projectID)
 	},
@@ -381,12 +429,24 @@ var ApiPath = struct {
 	VolumeResize: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/volumes/%s/resize", projectID, id)
 	},
+	VolumeRetype: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/volumes/%s/retype", projectID, id)
+	},
+	VolumeModifyIOPS: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/volumes/%s/modify-iops", projectID, id)
+	},
+	VolumeRevert: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/volumes/%s/revert", projectID, id)
+	},
 	VolumeAttach: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/volumes/%s/attach", projectID, id)
 	},
 	VolumeDetach: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/volumes/%s/detach", projectID, id)
 	},
+	VolumeTags: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/volumes/%s/tags", projectID, id)
+	},
 	VolumeAttachments: func(projectID string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/volume-attachments", projectID)
 	},
@@ -402,12 +462,42 @@ var ApiPath = struct {
 	InstanceResize: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/resize", projectID, id)
 	},
+	InstanceTags: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/tags", projectID, id)
+	},
+	InstanceStart: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/start", projectID, id)
+	},
+	InstanceStop: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/stop", projectID, id)
+	},
+	InstanceReboot: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/reboot", projectID, id)
+	},
+	InstanceRebuild: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/rebuild", projectID, id)
+	},
+	InstanceConsoleOutput: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/console-output", projectID, id)
+	},
+	InstanceConsoleURL: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/console-url", projectID, id)
+	},
+	InstanceMetadata: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/metadata", projectID, id)
+	},
+	InstanceMigrate: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/instances/%s/migrate", projectID, id)
+	},
 	ServerGroups: func(projectID string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/server-groups", projectID)
 	},
 	ServerGroupWithID: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/server-groups/%s", projectID, id)
 	},
+	ServerGroupPolicies: func(projectID string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/server-group-policies", projectID)
+	},
 	CreateKeyPair: func() string {
 		return "/v2/iac/key-pairs"
 	},
@@ -462,6 +552,24 @@ var ApiPath = struct {
 	SnapshotWithID: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/snapshots/%s", projectID, id)
 	},
+	SnapshotCopy: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/snapshots/%s/copy", projectID, id)
+	},
+	SnapshotPolicies: func(projectID string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/snapshot-policies", projectID)
+	},
+	SnapshotPolicyWithID: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/snapshot-policies/%s", projectID, id)
+	},
+	VolumeBackups: func(projectID string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/volume-backups", projectID)
+	},
+	VolumeBackupWithID: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/volume-backups/%s", projectID, id)
+	},
+	VolumeBackupRestore: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/volume-backups/%s/restore", projectID, id)
+	},
 	LoadBalancers: func(projectID string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/load-balancers", projectID)
 	},
@@ -471,6 +579,9 @@ var ApiPath = struct {
 	LoadBalancerChangeFlavor: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/load-balancers/%s/change-flavor", projectID, id)
 	},
+	LoadBalancerTags: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/load-balancers/%s/tags", projectID, id)
+	},
 	LBFlavors: func(projectID string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/lb-flavors", projectID)
 	},
@@ -543,6 +654,18 @@ var ApiPath = struct {
 	RouteTableWithID: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/route-tables/%s", projectID, id)
 	},
+	RouteTableAddRoutes: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/route-tables/%s/add-routes", projectID, id)
+	},
+	RouteTableRemoveRoutes: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/route-tables/%s/remove-routes", projectID, id)
+	},
+	RouteTableAssociateSubnet: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/route-tables/%s/associate-subnet", projectID, id)
+	},
+	RouteTableDisassociateSubnet: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/route-tables/%s/disassociate-subnet", projectID, id)
+	},
 	PrivateGateways: func(projectID string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/private-gateways", projectID)
 	},
@@ -600,6 +723,21 @@ var ApiPath = struct {
 	ImageWithID: func(id string) string {
 		return fmt.Sprintf("/v2/iac/images/%s", id)
 	},
+	ProjectImages: func(projectID string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/images", projectID)
+	},
+	ProjectImageWithID: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/images/%s", projectID, id)
+	},
+	ProjectImageFile: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/images/%s/file", projectID, id)
+	},
+	ProjectImageMembers: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/images/%s/members", projectID, id)
+	},
+	ProjectImageMemberWithID: func(projectID, id, memberProjectID string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/images/%s/members/%s", projectID, id, memberProjectID)
+	},
 	VolumeTypes: func(zone string) string {
 		return fmt.Sprintf("/v2/iac/volume-types?zone=%s", zone)
 	},
@@ -615,6 +753,9 @@ var ApiPath = struct {
 	BucketDelete: func(projectID, bucketName, region string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/buckets/%s?region=%s", projectID, bucketName, region)
 	},
+	BucketTags: func(projectID, bucketName string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/buckets/%s/tags", projectID, bucketName)
+	},
 	// Database Postgres Instance
 	DatabasePostgresInstances: func(projectID string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/database/postgres-instances", projectID)
@@ -643,6 +784,9 @@ var ApiPath = struct {
 	DatabasePostgresInstanceDisableTls: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/database/postgres-instances/%s/disable-tls", projectID, id)
 	},
+	DatabasePostgresInstanceTags: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/database/postgres-instances/%s/tags", projectID, id)
+	},
 
 	// Database Redis Instance
 	DatabaseRedisInstances: func(projectID string) string {
@@ -669,6 +813,9 @@ var ApiPath = struct {
 	DatabaseRedisInstanceDisableTls: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/database/redis-instances/%s/disable-tls", projectID, id)
 	},
+	DatabaseRedisInstanceTags: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/database/redis-instances/%s/tags", projectID, id)
+	},
 
 	// Database Redis Sentinel Instance
 	DatabaseRedisSentinelInstances: func(projectID string) string {
@@ -704,6 +851,9 @@ var ApiPath = struct {
 	DatabaseRedisSentinelInstanceDisableTls: func(projectID, id string) string {
 		return fmt.Sprintf("/v2/iac/projects/%s/database/redis-sentinel-instances/%s/disable-tls", projectID, id)
 	},
+	DatabaseRedisSentinelInstanceTags: func(projectID, id string) string {
+		return fmt.Sprintf("/v2/iac/projects/%s/database/redis-sentinel-instances/%s/tags", projectID, id)
+	},
 
 	// Database Flavor
 	DatabaseFlavors: func(projectID string) string {
@@ -21,12 +21,14 @@ func TestApiPathBuilders(t *testing.T) {
 		{"VPCs", ApiPath.VPCs(projectID), "/v2/iac/projects/proj-123", "/vpcs", ""},
 		{"VPCWithID", ApiPath.VPCWithID(projectID, resourceID), "", "", "/v2/iac/projects/proj-123/vpcs/res-456"},
 		{"VPCSetSNAT", ApiPath.VPCSetSNAT(projectID, resourceID), "", "/snat", ""},
+		{"VPCTags", ApiPath.VPCTags(projectID, resourceID), "", "/tags", ""},
 
 		// Subnet
 		{"Subnets", ApiPath.Subnets(projectID), "/v2/iac/projects/proj-123", "/subnets", ""},
 		{"SubnetWithID", ApiPath.SubnetWithID(projectID, resourceID), "", "", "/v2/iac/projects/proj-123/subnets/res-456"},
 		{"SubnetEnableSNAT", ApiPath.SubnetEnableSNAT(projectID, resourceID), "", "/enable-snat", ""},
 		{"SubnetDisableSNAT", ApiPath.SubnetDisableSNAT(projectID, resourceID), "", "/disable-snat", ""},
+		{"SubnetTags", ApiPath.SubnetTags(projectID, resourceID), "", "/tags", ""},
 
 		// Security Group
 		{"SecurityGroups", ApiPath.SecurityGroups(projectID), "/v2/iac/projects/proj-123", "/security-groups", ""},
@@ -52,8 +54,12 @@ func TestApiPathBuilders(t *testing.T) {
 		{"Volumes", ApiPath.Volumes(projectID), "/v2/iac/projects/proj-123", "", ""},
 		{"VolumeWithID", ApiPath.VolumeWithID(projectID, resourceID), "", resourceID, ""},
 		{"VolumeResize", ApiPath.VolumeResize(projectID, resourceID), "", "/resize", ""},
+		{"VolumeRetype", ApiPath.VolumeRetype(projectID, resourceID), "", "/retype", ""},
+		{"VolumeModifyIOPS", ApiPath.VolumeModifyIOPS(projectID, resourceID), "", "/modify-iops", ""},
+		{"VolumeRevert", ApiPath.VolumeRevert(projectID, resourceID), "", "/revert", ""},
 		{"VolumeAttach", ApiPath.VolumeAttach(projectID, resourceID), "", "/attach", ""},
 		{"VolumeDetach", ApiPath.VolumeDetach(projectID, resourceID), "", "/detach", ""},
+		{"VolumeTags", ApiPath.VolumeTags(projectID, resourceID), "", "/tags", ""},
 		{"VolumeAttachments", ApiPath.VolumeAttachments(projectID), "", "", ""},
 		{"VolumeAttachmentWithID", ApiPath.VolumeAttachmentWithID(projectID, resourceID), "", resourceID, ""},
 
@@ -61,10 +67,20 @@ func TestApiPathBuilders(t *testing.T) {
 		{"Instances", ApiPath.Instances(projectID), "/v2/iac/projects/proj-123", "", ""},
 		{"InstanceWithID", ApiPath.InstanceWithID(projectID, resourceID), "", resourceID, ""},
 		{"InstanceResize", ApiPath.InstanceResize(projectID, resourceID), "", "/resize", ""},
+		{"InstanceTags", ApiPath.InstanceTags(projectID, resourceID), "", "/tags", ""},
+		{"InstanceStart", ApiPath.InstanceStart(projectID, resourceID), "", "/start", ""},
+		{"InstanceStop", ApiPath.InstanceStop(projectID, resourceID), "", "/stop", ""},
+		{"InstanceReboot", ApiPath.InstanceReboot(projectID, resourceID), "", "/reboot", ""},
+		{"InstanceRebuild", ApiPath.InstanceRebuild(projectID, resourceID), "", "/rebuild", ""},
+		{"InstanceConsoleOutput", ApiPath.InstanceConsoleOutput(projectID, resourceID), "", "/console-output", ""},
+		{"InstanceConsoleURL", ApiPath.InstanceConsoleURL(projectID, resourceID), "", "/console-url", ""},
+		{"InstanceMetadata", ApiPath.InstanceMetadata(projectID, resourceID), "", "/metadata", ""},
+		{"InstanceMigrate", ApiPath.InstanceMigrate(projectID, resourceID), "", "/migrate", ""},
 
 		// Server Group
 		{"ServerGroups", ApiPath.ServerGroups(projectID), "/v2/iac/projects/proj-123", "", ""},
 		{"ServerGroupWithID", ApiPath.ServerGroupWithID(projectID, resourceID), "", resourceID, ""},
+		{"ServerGroupPolicies", ApiPath.ServerGroupPolicies(projectID), "/v2/iac/projects/proj-123/server-group-policies", "", ""},
 
 		// KeyPair
 		{"CreateKeyPair", ApiPath.CreateKeyPair(), "/v2/iac/", "", ""},
@@ -80,10 +96,21 @@ func TestApiPathBuilders(t *testing.T) {
 		// Snapshot
 		{"Snapshots", ApiPath.Snapshots(projectID), "/v2/iac/projects/proj-123", "", ""},
 		{"SnapshotWithID", ApiPath.SnapshotWithID(projectID, resourceID), "", resourceID, ""},
+		{"SnapshotCopy", ApiPath.SnapshotCopy(projectID, resourceID), "", "/copy", ""},
+
+		// Snapshot Policy
+		{"SnapshotPolicies", ApiPath.SnapshotPolicies(projectID), "/v2/iac/projects/proj-123/snapshot-policies", "", ""},
+		{"SnapshotPolicyWithID", ApiPath.SnapshotPolicyWithID(projectID, resourceID), "", resourceID, ""},
+
+		// Volume Backup
+		{"VolumeBackups", ApiPath.VolumeBackups(projectID), "/v2/iac/projects/proj-123/volume-backups", "", ""},
+		{"VolumeBackupWithID", ApiPath.VolumeBackupWithID(projectID, resourceID), "", resourceID, ""},
+		{"VolumeBackupRestore", ApiPath.VolumeBackupRestore(projectID, resourceID), "", "/restore", ""},
 
 		// Load Balancer
 		{"LoadBalancers", ApiPath.LoadBalancers(projectID), "/v2/iac/projects/proj-123", "", ""},
 		{"LoadBalancerWithID", ApiPath.LoadBalancerWithID(projectID, resourceID), "", resourceID, ""},
+		{"LoadBalancerTags", ApiPath.LoadBalancerTags(projectID, resourceID), "", "/tags", ""},
 		{"LBFlavors", ApiPath.LBFlavors(projectID), "/v2/iac/projects/proj-123/lb-flavors", "", ""},
 
 		// Listener
@@ -115,6 +142,10 @@ func TestApiPathBuilders(t *testing.T) {
 		// Route Table
 		{"RouteTables", ApiPath.RouteTables(projectID), "/v2/iac/projects/proj-123", "", ""},
 		{"RouteTableWithID", ApiPath.RouteTableWithID(projectID, resourceID), "", resourceID, ""},
+		{"RouteTableAddRoutes", ApiPath.RouteTableAddRoutes(projectID, resourceID), "", "/add-routes", ""},
+		{"RouteTableRemoveRoutes", ApiPath.RouteTableRemoveRoutes(projectID, resourceID), "", "/remove-routes", ""},
+		{"RouteTableAssociateSubnet", ApiPath.RouteTableAssociateSubnet(projectID, resourceID), "", "/associate-subnet", ""},
+		{"RouteTableDisassociateSubnet", ApiPath.RouteTableDisassociateSubnet(projectID, resourceID), "", "/disassociate-subnet", ""},
 
 		// Private Gateway
 		{"PrivateGateways", ApiPath.PrivateGateways(projectID), "/v2/iac/projects/proj-123", "", ""},
@@ -144,6 +175,11 @@ func TestApiPathBuilders(t *testing.T) {
 		// Image (global, zone-scoped)
 		{"Images", ApiPath.Images(zone), "/v2/iac/", zone, ""},
 		{"ImageWithID", ApiPath.ImageWithID(resourceID), "", resourceID, ""},
+		{"ProjectImages", ApiPath.ProjectImages(projectID), "/v2/iac/projects/proj-123", "/images", ""},
+		{"ProjectImageWithID", ApiPath.ProjectImageWithID(projectID, resourceID), "", "", "/v2/iac/projects/proj-123/images/res-456"},
+		{"ProjectImageFile", ApiPath.ProjectImageFile(projectID, resourceID), "", "/file", ""},
+		{"ProjectImageMembers", ApiPath.ProjectImageMembers(projectID, resourceID), "", "/members", ""},
+		{"ProjectImageMemberWithID", ApiPath.ProjectImageMemberWithID(projectID, resourceID, "proj-789"), "", "/members/proj-789", ""},
 
 		// Volume Type (global, zone-scoped)
 		{"VolumeTypes", ApiPath.VolumeTypes(zone), "/v2/iac/", zone, ""},
@@ -152,6 +188,7 @@ func TestApiPathBuilders(t *testing.T) {
 		// S3 Bucket
 		{"Buckets", ApiPath.Buckets(projectID), "/v2/iac/projects/proj-123", "", ""},
 		{"BucketUsage", ApiPath.BucketUsage(projectID, "my-bucket"), "", "/usage", ""},
+		{"BucketTags", ApiPath.BucketTags(projectID, "my-bucket"), "", "/buckets/my-bucket/tags", ""},
 		{"BucketDelete", ApiPath.BucketDelete(projectID, "my-bucket", "us-east-1"), "", "us-east-1", ""},
 
 		// Database Postgres Instance
@@ -164,6 +201,7 @@ func TestApiPathBuilders(t *testing.T) {
 		{"DatabasePostgresInstanceDisableAutoExpandVolume", ApiPath.DatabasePostgresInstanceDisableAutoExpandVolume(projectID, resourceID), "", "/disable-auto-expand-volume", ""},
 		{"DatabasePostgresInstanceEnableTls", ApiPath.DatabasePostgresInstanceEnableTls(projectID, resourceID), "", "/enable-tls", ""},
 		{"DatabasePostgresInstanceDisableTls", ApiPath.DatabasePostgresInstanceDisableTls(projectID, resourceID), "", "/disable-tls", ""},
+		{"DatabasePostgresInstanceTags", ApiPath.DatabasePostgresInstanceTags(projectID, resourceID), "", "/tags", ""},
 
 		// Database Redis Instance
 		{"DatabaseRedisInstances", ApiPath.DatabaseRedisInstances(projectID), "/v2/iac/projects/proj-123", "/database/redis-instances", ""},
@@ -174,6 +212,7 @@ func TestApiPathBuilders(t *testing.T) {
 		{"DatabaseRedisInstanceDisableAutoExpandVolume", ApiPath.DatabaseRedisInstanceDisableAutoExpandVolume(projectID, resourceID), "", "/disable-auto-expand-volume", ""},
 		{"DatabaseRedisInstanceEnableTls", ApiPath.DatabaseRedisInstanceEnableTls(projectID, resourceID), "", "/enable-tls", ""},
 		{"DatabaseRedisInstanceDisableTls", ApiPath.DatabaseRedisInstanceDisableTls(projectID, resourceID), "", "/disable-tls", ""},
+		{"DatabaseRedisInstanceTags", ApiPath.DatabaseRedisInstanceTags(projectID, resourceID), "", "/tags", ""},
 
 		// Database Redis Sentinel Instance
 		{"DatabaseRedisSentinelInstances", ApiPath.DatabaseRedisSentinelInstances(projectID), "/v2/iac/projects/proj-123", "/database/redis-sentinel-instances", ""},
@@ -187,6 +226,7 @@ func TestApiPathBuilders(t *testing.T) {
 		{"DatabaseRedisSentinelInstanceSentinelChangeFlavor", ApiPath.DatabaseRedisSentinelInstanceSentinelChangeFlavor(projectID, resourceID), "", "/sentinel-change-flavor", ""},
 		{"DatabaseRedisSentinelInstanceEnableTls", ApiPath.DatabaseRedisSentinelInstanceEnableTls(projectID, resourceID), "", "/enable-tls", ""},
 		{"DatabaseRedisSentinelInstanceDisableTls", ApiPath.DatabaseRedisSentinelInstanceDisableTls(projectID, resourceID), "", "/disable-tls", ""},
+		{"DatabaseRedisSentinelInstanceTags", ApiPath.DatabaseRedisSentinelInstanceTags(projectID, resourceID), "", "/tags", ""},
 
 		// Database Flavor
 		{"DatabaseFlavors", ApiPath.DatabaseFlavors(projectID), "/v2/iac/projects/proj-123", "/database/flavor-databases", ""},
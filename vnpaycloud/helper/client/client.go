@@ -7,7 +7,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"math/rand/v2"
 	"net"
 	"net/http"
 	"strings"
@@ -26,11 +25,19 @@ type Client struct {
 	baseURL    string
 	token      string
 	httpClient http.Client
+	retry      *RetryPolicy
+	limiter    *rateLimiter
 }
 
 type ClientConfig struct {
 	BaseURL string
 	Token   string
+	Retry   *RetryPolicy // Optional; DefaultRetryPolicy is used when nil
+
+	// MaxRequestsPerSecond enables a client-side token-bucket limiter shared
+	// by all requests. Zero disables it. Burst is the bucket size.
+	MaxRequestsPerSecond float64
+	Burst                int
 }
 
 func NewClient(_ context.Context, cfg *ClientConfig) (*Client, error) {
@@ -49,6 +56,11 @@ func NewClient(_ context.Context, cfg *ClientConfig) (*Client, error) {
 		TLSHandshakeTimeout: 10 * time.Second,
 	}
 
+	retry := cfg.Retry
+	if retry == nil {
+		retry = DefaultRetryPolicy()
+	}
+
 	return &Client{
 		baseURL: strings.TrimRight(cfg.BaseURL, "/"),
 		token:   cfg.Token,
@@ -56,9 +68,21 @@ func NewClient(_ context.Context, cfg *ClientConfig) (*Client, error) {
 			Transport: transport,
 			Timeout:   180 * time.Second,
 		},
+		retry:   retry,
+		limiter: newRateLimiter(cfg.MaxRequestsPerSecond, cfg.Burst),
 	}, nil
 }
 
+// WithoutTimeout returns a copy of the client whose requests have no overall
+// timeout, for long-running transfers such as image uploads. Such requests
+// are bounded only by their context, so callers must pass one with a
+// deadline. The copy shares the transport, retry policy and rate limiter.
+func (client *Client) WithoutTimeout() *Client {
+	c := *client
+	c.httpClient.Timeout = 0
+	return &c
+}
+
 type RequestOpts struct {
 	JSONBody         any
 	RawBody          io.Reader
@@ -110,49 +134,76 @@ func (client *Client) Delete(ctx context.Context, path string, opts *RequestOpts
 }
 
 func (client *Client) doRequest(ctx context.Context, method, url string, options *RequestOpts) (*http.Response, error) {
-	const (
-		maxTransientRetries = 3 // 503 Service Unavailable (network blips)
-		maxRateLimitRetries = 4 // 429 / "Too Many Requests" — longer backoff, backend rate-limits aggressively
-	)
+	policy := client.retry
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
 
 	transientAttempts := 0
 	rateLimitAttempts := 0
 
 	for {
+		waited, err := client.limiter.wait(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if waited > 0 {
+			tflog.Debug(ctx, "Waited for client-side rate limiter", map[string]interface{}{
+				"url":    url,
+				"method": method,
+				"wait":   waited.String(),
+			})
+		}
+
 		resp, err := client.doRequestOnce(ctx, method, url, options)
 		if err == nil {
 			return resp, nil
 		}
 
-		var respErr ErrUnexpectedResponseCode
-		if !errors.As(err, &respErr) {
-			return resp, err
-		}
-
-		isRateLimited := respErr.Actual == http.StatusTooManyRequests ||
-			strings.Contains(string(respErr.Body), "Too Many Requests")
-		isTransient := respErr.Actual == http.StatusServiceUnavailable && !isRateLimited
-
 		var backoff time.Duration
+		var respErr ErrUnexpectedResponseCode
+		rateLimited := false
 		switch {
-		case isRateLimited:
-			if rateLimitAttempts >= maxRateLimitRetries {
+		case errors.As(err, &respErr) && isRateLimited(respErr):
+			if rateLimitAttempts+1 >= policy.RateLimitMaxAttempts {
 				return resp, err
 			}
-
-			backoff = time.Duration(30*(rateLimitAttempts+1)) * time.Second // 30s, 60s, 90s, 120s
-			backoff += time.Duration(rand.Int64N(int64(backoff) / 4))       // +0–25% jitter
+			backoff = policy.rateLimitBackoff(rateLimitAttempts)
 			rateLimitAttempts++
-		case isTransient:
-			if transientAttempts >= maxTransientRetries {
+			rateLimited = true
+		case errors.As(err, &respErr) && policy.isRetryableStatus(respErr.Actual),
+			policy.RetryNetworkErrors && isRetryableNetworkError(ctx, method, err):
+			if transientAttempts+1 >= policy.MaxAttempts {
 				return resp, err
 			}
-			backoff = time.Duration(1<<uint(transientAttempts)) * time.Second // 1s, 2s, 4s
+			backoff = policy.transientBackoff(transientAttempts)
 			transientAttempts++
 		default:
 			return resp, err
 		}
 
+		if respErr.ResponseHeader != nil {
+			if d, ok := retryAfter(respErr.ResponseHeader); ok {
+				// A rate-limited response is retried no sooner than the
+				// server asks, so the bucket can clear.
+				if !rateLimited && policy.MaxBackoff > 0 && d > policy.MaxBackoff {
+					d = policy.MaxBackoff
+				}
+				backoff = d
+			}
+		}
+
+		if !rewindBody(options) {
+			return resp, err
+		}
+
+		tflog.Debug(ctx, "Retrying request", map[string]interface{}{
+			"url":     url,
+			"method":  method,
+			"backoff": backoff.String(),
+			"error":   err.Error(),
+		})
+
 		select {
 		case <-ctx.Done():
 			return resp, ctx.Err()
@@ -6,10 +6,13 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"sync/atomic"
+	"syscall"
 	"testing"
 	"time"
 )
@@ -74,6 +77,60 @@ func newTestClient(t *testing.T, serverURL string) *Client {
 	return c
 }
 
+func newTestClientWithRetry(t *testing.T, serverURL string, policy *RetryPolicy) *Client {
+	t.Helper()
+	c, err := NewClient(context.Background(), &ClientConfig{
+		BaseURL: serverURL,
+		Token:   "test-token",
+		Retry:   policy,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return c
+}
+
+func TestWithoutTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	policy := fastRetryPolicy()
+	policy.RetryNetworkErrors = false
+	c := newTestClientWithRetry(t, server.URL, policy)
+	c.httpClient.Timeout = 50 * time.Millisecond
+
+	if _, err := c.Put(context.Background(), "/upload", nil, nil, nil); err == nil {
+		t.Fatal("expected the client timeout to abort the request")
+	}
+
+	upload := c.WithoutTimeout()
+	if c.httpClient.Timeout != 50*time.Millisecond {
+		t.Errorf("expected the original client timeout to be unchanged, got %s", c.httpClient.Timeout)
+	}
+	if _, err := upload.Put(context.Background(), "/upload", nil, nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := upload.Put(ctx, "/upload", nil, nil, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the context deadline to abort the request, got %v", err)
+	}
+}
+
+// fastRetryPolicy returns the default policy with millisecond backoffs so
+// retry tests do not sleep for real.
+func fastRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.BaseBackoff = time.Millisecond
+	p.MaxBackoff = 5 * time.Millisecond
+	p.RateLimitBackoff = time.Millisecond
+	return p
+}
+
 func TestHTTPMethods(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -566,7 +623,10 @@ func TestMaxRetriesExhausted(t *testing.T) {
 	}))
 	defer srv.Close()
 
-	c := newTestClient(t, srv.URL)
+	policy := fastRetryPolicy()
+	policy.RateLimitMaxAttempts = 4
+
+	c := newTestClientWithRetry(t, srv.URL, policy)
 	_, err := c.Get(context.Background(), "/max-retry", nil, nil)
 	if err == nil {
 		t.Fatal("expected error after max retries")
@@ -607,6 +667,285 @@ func TestContextCancellationStopsRetry(t *testing.T) {
 	}
 }
 
+func TestRetryOnGatewayErrors(t *testing.T) {
+	for _, code := range []int{http.StatusBadGateway, http.StatusGatewayTimeout} {
+		t.Run(http.StatusText(code), func(t *testing.T) {
+			var attempts int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) <= 2 {
+					w.WriteHeader(code)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer srv.Close()
+
+			c := newTestClientWithRetry(t, srv.URL, fastRetryPolicy())
+			if _, err := c.Get(context.Background(), "/gateway", nil, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := atomic.LoadInt32(&attempts); got != 3 {
+				t.Errorf("expected 3 attempts, got %d", got)
+			}
+		})
+	}
+}
+
+func TestTransientMaxAttempts(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	policy := fastRetryPolicy()
+	policy.MaxAttempts = 2
+
+	c := newTestClientWithRetry(t, srv.URL, policy)
+	if _, err := c.Get(context.Background(), "/unavailable", nil, nil); err == nil {
+		t.Fatal("expected error after max attempts")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestCustomRetryableStatusCodes(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	policy := fastRetryPolicy()
+	policy.RetryableStatusCodes = []int{http.StatusInternalServerError}
+
+	c := newTestClientWithRetry(t, srv.URL, policy)
+	if _, err := c.Get(context.Background(), "/custom", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+// TestRetryHonoursRetryAfter verifies that a 429 waits for the full
+// Retry-After, even when it exceeds MaxBackoff.
+func TestRetryHonoursRetryAfter(t *testing.T) {
+	var attempts int32
+	var first, second time.Time
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			first = time.Now()
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		second = time.Now()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	// MaxBackoff (5ms) is below Retry-After: it must not cap a 429.
+	c := newTestClientWithRetry(t, srv.URL, fastRetryPolicy())
+	if _, err := c.Get(context.Background(), "/retry-after", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if waited := second.Sub(first); waited < 900*time.Millisecond {
+		t.Errorf("expected client to wait for Retry-After (1s), waited %s", waited)
+	}
+}
+
+func TestRetryAfterCappedAtMaxBackoff(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := newTestClientWithRetry(t, srv.URL, fastRetryPolicy())
+	start := time.Now()
+	if _, err := c.Get(context.Background(), "/retry-after", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if waited := time.Since(start); waited > time.Second {
+		t.Errorf("expected Retry-After to be capped at MaxBackoff, waited %s", waited)
+	}
+}
+
+func TestRetryOnNetworkError(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			// Drop the connection without writing a response.
+			conn, _, err := w.(http.Hijacker).Hijack()
+			if err != nil {
+				t.Fatalf("hijack failed: %v", err)
+			}
+			_ = conn.Close()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := newTestClientWithRetry(t, srv.URL, fastRetryPolicy())
+	if _, err := c.Get(context.Background(), "/reset", nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestNoRetryOnNetworkErrorWhenDisabled(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatalf("hijack failed: %v", err)
+		}
+		_ = conn.Close()
+	}))
+	defer srv.Close()
+
+	policy := fastRetryPolicy()
+	policy.RetryNetworkErrors = false
+
+	c := newTestClientWithRetry(t, srv.URL, policy)
+	if _, err := c.Get(context.Background(), "/reset", nil, nil); err == nil {
+		t.Fatal("expected network error")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("expected 1 attempt (no retry), got %d", got)
+	}
+}
+
+// TestNoRetryOnNetworkErrorForPost verifies that a POST whose connection
+// dropped after it was sent is not replayed, since the server may already
+// have created the resource.
+func TestNoRetryOnNetworkErrorForPost(t *testing.T) {
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatalf("hijack failed: %v", err)
+		}
+		_ = conn.Close()
+	}))
+	defer srv.Close()
+
+	c := newTestClientWithRetry(t, srv.URL, fastRetryPolicy())
+	if _, err := c.Post(context.Background(), "/create", map[string]string{"name": "x"}, nil, nil); err == nil {
+		t.Fatal("expected network error")
+	}
+	if got := atomic.LoadInt32(&attempts); got != 1 {
+		t.Errorf("expected 1 attempt (no retry), got %d", got)
+	}
+}
+
+func TestIsRetryableNetworkError(t *testing.T) {
+	dialErr := &url.Error{Op: "Post", URL: "http://x", Err: &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}}
+	readErr := &url.Error{Op: "Post", URL: "http://x", Err: &net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}}
+	eofErr := &url.Error{Op: "Post", URL: "http://x", Err: io.EOF}
+
+	tests := []struct {
+		name   string
+		method string
+		err    error
+		want   bool
+	}{
+		{"POST dial error", http.MethodPost, dialErr, true},
+		{"POST read error", http.MethodPost, readErr, false},
+		{"POST EOF", http.MethodPost, eofErr, false},
+		{"GET read error", http.MethodGet, readErr, true},
+		{"PUT EOF", http.MethodPut, eofErr, true},
+		{"DELETE read error", http.MethodDelete, readErr, true},
+		{"not a network error", http.MethodGet, errors.New("decode failed"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableNetworkError(context.Background(), tt.method, tt.err); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRetryRewindsRawBody(t *testing.T) {
+	var attempts int32
+	var lastBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		lastBody = string(body)
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := newTestClientWithRetry(t, srv.URL, fastRetryPolicy())
+	if _, err := c.Put(context.Background(), "/upload", bytes.NewReader([]byte("payload")), nil, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lastBody != "payload" {
+		t.Errorf("expected retried body %q, got %q", "payload", lastBody)
+	}
+}
+
+func TestRetryAfterHeaderParsing(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"missing", "", 0, false},
+		{"seconds", "5", 5 * time.Second, true},
+		{"negative", "-1", 0, false},
+		{"past date", "Mon, 02 Jan 2006 15:04:05 GMT", 0, true},
+		{"garbage", "soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			if tt.value != "" {
+				h.Set("Retry-After", tt.value)
+			}
+			got, ok := retryAfter(h)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("retryAfter(%q) = (%s, %v), want (%s, %v)", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestTransientBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseBackoff: time.Second, MaxBackoff: 5 * time.Second}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for i, w := range want {
+		if got := p.transientBackoff(i); got != w {
+			t.Errorf("transientBackoff(%d) = %s, want %s", i, got, w)
+		}
+	}
+}
+
 func TestInitReqOptsWithIOReader(t *testing.T) {
 	c := &Client{}
 	reader := bytes.NewReader([]byte("test"))
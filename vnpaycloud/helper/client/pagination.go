@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// maxListPages bounds ListAll so a misbehaving endpoint cannot keep the
+// provider paging forever.
+const maxListPages = 1000
+
+// pageInfo holds the pagination metadata the backend attaches to list
+// responses. Token-paginated endpoints return nextPageToken, offset/limit
+// paginated endpoints return total.
+type pageInfo struct {
+	NextPageToken string `json:"nextPageToken"`
+	Total         int    `json:"total"`
+}
+
+// pageOpts are the query parameters sent to request the next page.
+type pageOpts struct {
+	PageToken string `q:"pageToken"`
+	Offset    int    `q:"offset"`
+	Limit     int    `q:"limit"`
+}
+
+// ListAll fetches every page of the list endpoint at path and returns the
+// combined items. Each page is decoded into R and items extracts the page
+// items from it. Pagination follows nextPageToken when the backend returns
+// one, and otherwise offset/limit while fewer than total items have been
+// collected. Endpoints returning neither are read in a single request. A page
+// starting with the same item as the previous one means the backend ignored
+// the page query, and fails rather than collecting duplicates.
+func ListAll[R any, E any](ctx context.Context, c *Client, path string, items func(*R) []E) ([]E, error) {
+	var all []E
+	var opts pageOpts
+	var prevPage []E
+	seenTokens := make(map[string]bool)
+
+	for page := 0; page < maxListPages; page++ {
+		pagePath, err := WithQuery(path, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		var raw json.RawMessage
+		if _, err := c.Get(ctx, pagePath, &raw, nil); err != nil {
+			return nil, err
+		}
+		if len(raw) == 0 {
+			return all, nil
+		}
+
+		resp := new(R)
+		if err := json.Unmarshal(raw, resp); err != nil {
+			return nil, err
+		}
+
+		var info pageInfo
+		if err := json.Unmarshal(raw, &info); err != nil {
+			return nil, err
+		}
+
+		pageItems := items(resp)
+		if len(pageItems) > 0 && len(prevPage) > 0 && reflect.DeepEqual(pageItems[0], prevPage[0]) {
+			return nil, fmt.Errorf("pagination of %s made no progress: page %d starts with the same item as the previous page", path, page+1)
+		}
+		prevPage = pageItems
+		all = append(all, pageItems...)
+
+		switch {
+		case info.NextPageToken != "":
+			if seenTokens[info.NextPageToken] {
+				return nil, fmt.Errorf("pagination of %s returned page token %q twice", path, info.NextPageToken)
+			}
+			seenTokens[info.NextPageToken] = true
+			opts = pageOpts{PageToken: info.NextPageToken}
+		case len(pageItems) > 0 && info.Total > len(all):
+			opts = pageOpts{Offset: len(all), Limit: len(pageItems)}
+		default:
+			return all, nil
+		}
+	}
+
+	return nil, fmt.Errorf("pagination of %s exceeded %d pages", path, maxListPages)
+}
+
+// WithQuery appends the query parameters built from opts, a struct with `q`
+// tags, to path. Parameters already on path are kept.
+func WithQuery(path string, opts any) (string, error) {
+	q, err := BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	if q.RawQuery == "" {
+		return path, nil
+	}
+
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	return path + sep + q.RawQuery, nil
+}
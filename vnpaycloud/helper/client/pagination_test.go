@@ -0,0 +1,175 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type testItem struct {
+	ID string `json:"id"`
+}
+
+type testListResponse struct {
+	Items []testItem `json:"items"`
+}
+
+func testItems(r *testListResponse) []testItem { return r.Items }
+
+func writeJSON(t *testing.T, w http.ResponseWriter, body any) {
+	t.Helper()
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		t.Errorf("failed to encode response: %v", err)
+	}
+}
+
+func itemIDs(items []testItem) string {
+	ids := make([]string, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestListAllSinglePage(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.RawQuery != "" {
+			t.Errorf("expected no query on first page, got %q", r.URL.RawQuery)
+		}
+		writeJSON(t, w, map[string]any{"items": []testItem{{ID: "a"}, {ID: "b"}}})
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL)
+	items, err := ListAll(context.Background(), c, "/items", testItems)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := itemIDs(items); got != "a,b" {
+		t.Errorf("expected items a,b, got %s", got)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 request, got %d", calls)
+	}
+}
+
+func TestListAllPageToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("pageToken") {
+		case "":
+			writeJSON(t, w, map[string]any{"items": []testItem{{ID: "a"}}, "nextPageToken": "p2"})
+		case "p2":
+			writeJSON(t, w, map[string]any{"items": []testItem{{ID: "b"}}, "nextPageToken": "p3"})
+		case "p3":
+			writeJSON(t, w, map[string]any{"items": []testItem{{ID: "c"}}})
+		default:
+			t.Errorf("unexpected page token %q", r.URL.Query().Get("pageToken"))
+		}
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL)
+	items, err := ListAll(context.Background(), c, "/items", testItems)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := itemIDs(items); got != "a,b,c" {
+		t.Errorf("expected items a,b,c, got %s", got)
+	}
+}
+
+func TestListAllOffsetLimit(t *testing.T) {
+	all := []testItem{{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "d"}, {ID: "e"}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("zone"); got != "z1" {
+			t.Errorf("expected existing zone query to be preserved, got %q", got)
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := offset + 2
+		if end > len(all) {
+			end = len(all)
+		}
+		writeJSON(t, w, map[string]any{"items": all[offset:end], "total": len(all)})
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL)
+	items, err := ListAll(context.Background(), c, "/items?zone=z1", testItems)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := itemIDs(items); got != "a,b,c,d,e" {
+		t.Errorf("expected items a,b,c,d,e, got %s", got)
+	}
+}
+
+func TestListAllStopsOnEmptyPage(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.URL.Query().Get("offset") != "" {
+			writeJSON(t, w, map[string]any{"items": []testItem{}, "total": 10})
+			return
+		}
+		writeJSON(t, w, map[string]any{"items": []testItem{{ID: "a"}}, "total": 10})
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL)
+	items, err := ListAll(context.Background(), c, "/items", testItems)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 1 || calls != 2 {
+		t.Errorf("expected 1 item after 2 requests, got %d items after %d requests", len(items), calls)
+	}
+}
+
+func TestListAllRepeatedPageToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(t, w, map[string]any{"items": []testItem{{ID: "a"}}, "nextPageToken": "same"})
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL)
+	if _, err := ListAll(context.Background(), c, "/items", testItems); err == nil {
+		t.Fatal("expected error for repeated page token")
+	}
+}
+
+func TestListAllIgnoredOffset(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		writeJSON(t, w, map[string]any{"items": []testItem{{ID: "a"}, {ID: "b"}}, "total": 5})
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL)
+	_, err := ListAll(context.Background(), c, "/items", testItems)
+	if err == nil || !strings.Contains(err.Error(), "made no progress") {
+		t.Fatalf("expected no progress error, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 requests, got %d", calls)
+	}
+}
+
+func TestListAllError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL)
+	if _, err := ListAll(context.Background(), c, "/items", testItems); err == nil {
+		t.Fatal("expected error for 403 response")
+	}
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimiterDisabled(t *testing.T) {
+	if l := newRateLimiter(0, 10); l != nil {
+		t.Fatalf("expected nil limiter for rps=0, got %+v", l)
+	}
+
+	var l *rateLimiter
+	waited, err := l.wait(context.Background())
+	if err != nil || waited != 0 {
+		t.Errorf("expected nil limiter to never wait, got (%s, %v)", waited, err)
+	}
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	l := newRateLimiter(1, 3)
+
+	for i := 0; i < 3; i++ {
+		if d := l.reserve(); d != 0 {
+			t.Fatalf("request %d within burst should not wait, got %s", i, d)
+		}
+	}
+	if d := l.reserve(); d <= 0 {
+		t.Error("expected request beyond burst to wait")
+	}
+}
+
+func TestRateLimiterWaitCancelled(t *testing.T) {
+	l := newRateLimiter(0.1, 1)
+	l.reserve()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := l.wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestClientRateLimitsRequests(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c, err := NewClient(context.Background(), &ClientConfig{
+		BaseURL:              srv.URL,
+		Token:                "test-token",
+		MaxRequestsPerSecond: 20,
+		Burst:                1,
+	})
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := c.Get(context.Background(), "/limited", nil, nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// Burst of 1 at 20 rps: 4 requests have to wait ~50ms each.
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("expected requests to be paced (>=180ms), took %s", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got != 5 {
+		t.Errorf("expected 5 requests, got %d", got)
+	}
+}
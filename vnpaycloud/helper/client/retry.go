@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy controls how the client retries failed requests.
+//
+// Transient failures (RetryableStatusCodes and, when enabled, network errors)
+// use exponential backoff starting at BaseBackoff and capped at MaxBackoff.
+// Rate-limited responses (429 or a "Too Many Requests" body) use a separate,
+// longer linear backoff so the backend rate-limit bucket has time to clear.
+// A Retry-After header on the response takes precedence over the computed
+// backoff. It is capped at MaxBackoff for transient failures only; for
+// rate-limited responses it is honoured as given.
+//
+// Network errors are only retried when replaying the request is safe: for
+// idempotent methods, or for any method when the connection could not be
+// established, so the request never reached the server.
+type RetryPolicy struct {
+	MaxAttempts          int // Total attempts for transient failures, including the first request
+	BaseBackoff          time.Duration
+	MaxBackoff           time.Duration
+	RetryableStatusCodes []int
+	RetryNetworkErrors   bool
+	RateLimitMaxAttempts int // Total attempts for rate-limited responses, including the first request
+	RateLimitBackoff     time.Duration
+}
+
+// DefaultRetryPolicy returns the policy used when ClientConfig.Retry is nil.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:          4, // 1s, 2s, 4s
+		BaseBackoff:          1 * time.Second,
+		MaxBackoff:           30 * time.Second,
+		RetryableStatusCodes: []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout},
+		RetryNetworkErrors:   true,
+		RateLimitMaxAttempts: 5, // 30s, 60s, 90s, 120s
+		RateLimitBackoff:     30 * time.Second,
+	}
+}
+
+// transientBackoff returns the exponential backoff before retry number
+// attempt (zero-based), capped at MaxBackoff.
+func (p *RetryPolicy) transientBackoff(attempt int) time.Duration {
+	backoff := p.BaseBackoff << uint(attempt)
+	if backoff <= 0 || (p.MaxBackoff > 0 && backoff > p.MaxBackoff) {
+		backoff = p.MaxBackoff
+	}
+	return backoff
+}
+
+// rateLimitBackoff returns the linear backoff before rate-limit retry number
+// attempt (zero-based), plus 0–25% jitter.
+func (p *RetryPolicy) rateLimitBackoff(attempt int) time.Duration {
+	backoff := p.RateLimitBackoff * time.Duration(attempt+1)
+	if backoff/4 > 0 {
+		backoff += time.Duration(rand.Int64N(int64(backoff / 4)))
+	}
+	return backoff
+}
+
+func (p *RetryPolicy) isRetryableStatus(code int) bool {
+	return slices.Contains(p.RetryableStatusCodes, code)
+}
+
+func isRateLimited(respErr ErrUnexpectedResponseCode) bool {
+	return respErr.Actual == http.StatusTooManyRequests ||
+		strings.Contains(string(respErr.Body), "Too Many Requests")
+}
+
+// isRetryableNetworkError reports whether err is a transport-level failure
+// (connection reset/refused, timeout, unexpected EOF) raised by the HTTP
+// client, as opposed to a response or decoding error, that is safe to retry
+// for method. A non-idempotent request is only retried when dialing failed,
+// since otherwise the server may already have acted on it.
+func isRetryableNetworkError(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return false
+	}
+
+	var opErr *net.OpError
+	isOpErr := errors.As(err, &opErr)
+	if isOpErr && opErr.Op == "dial" {
+		return true
+	}
+
+	if !isIdempotentMethod(method) {
+		return false
+	}
+
+	if isOpErr || urlErr.Timeout() {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, io.EOF) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryAfter parses the Retry-After response header, which is either a
+// number of seconds or an HTTP date.
+func retryAfter(header http.Header) (time.Duration, bool) {
+	v := strings.TrimSpace(header.Get("Retry-After"))
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// rewindBody resets a seekable RawBody so it can be re-sent on retry. It
+// reports false when the body has been consumed and cannot be replayed.
+func rewindBody(options *RequestOpts) bool {
+	if options.RawBody == nil {
+		return true
+	}
+
+	seeker, ok := options.RawBody.(io.Seeker)
+	if !ok {
+		return false
+	}
+
+	_, err := seeker.Seek(0, io.SeekStart)
+	return err == nil
+}
@@ -0,0 +1,116 @@
+package image
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+func imageStateRefreshFunc(ctx context.Context, c *client.Client, projectID, imageID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		imgResp := &dto.ImageResponse{}
+		_, err := c.Get(ctx, client.ApiPath.ProjectImageWithID(projectID, imageID), imgResp, nil)
+
+		if err != nil {
+			if util.ResponseCodeIs(err, http.StatusNotFound) {
+				return imgResp.Image, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if imgResp.Image.Status == "killed" || imgResp.Image.Status == "error" {
+			return imgResp.Image, imgResp.Image.Status, fmt.Errorf("The image is in error status. " +
+				"Please check with your cloud admin or check the API logs.")
+		}
+
+		return imgResp.Image, imgResp.Image.Status, nil
+	}
+}
+
+// diffImageMembers returns the project IDs to share the image with and to
+// stop sharing it with.
+func diffImageMembers(oldSet, newSet []interface{}) (add, remove []string) {
+	oldIDs := make(map[string]bool, len(oldSet))
+	for _, v := range oldSet {
+		oldIDs[v.(string)] = true
+	}
+	newIDs := make(map[string]bool, len(newSet))
+	for _, v := range newSet {
+		newIDs[v.(string)] = true
+		if !oldIDs[v.(string)] {
+			add = append(add, v.(string))
+		}
+	}
+	for _, v := range oldSet {
+		if !newIDs[v.(string)] {
+			remove = append(remove, v.(string))
+		}
+	}
+	return add, remove
+}
+
+// updateImageMembers shares the image with the projects in add and revokes
+// access for those in remove. Members already gone are ignored.
+func updateImageMembers(ctx context.Context, c *client.Client, projectID, imageID string, add, remove []string) error {
+	for _, memberID := range add {
+		memberOpts := dto.AddImageMemberRequest{ProjectID: memberID}
+		if _, err := c.Post(ctx, client.ApiPath.ProjectImageMembers(projectID, imageID), memberOpts, nil, nil); err != nil {
+			return fmt.Errorf("sharing with project %s: %s", memberID, err)
+		}
+	}
+
+	for _, memberID := range remove {
+		if _, err := c.Delete(ctx, client.ApiPath.ProjectImageMemberWithID(projectID, imageID, memberID), nil); err != nil {
+			if util.ResponseCodeIs(err, http.StatusNotFound) {
+				continue
+			}
+			return fmt.Errorf("unsharing with project %s: %s", memberID, err)
+		}
+	}
+
+	return nil
+}
+
+// fileSHA256 returns the hex-encoded SHA-256 checksum of the file at path.
+func fileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// uploadImageFile streams the file at path as the data of the image. The
+// file is passed as the raw request body, so it is never held in memory and
+// can be rewound when the request is retried. Large images can take longer
+// than the client's request timeout, so the upload is bounded by ctx (the
+// Create timeout) instead.
+func uploadImageFile(ctx context.Context, c *client.Client, projectID, imageID, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	opts := &client.RequestOpts{
+		MoreHeaders: map[string]string{"Content-Type": "application/octet-stream"},
+		OkCodes:     []int{http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent},
+	}
+	_, err = c.WithoutTimeout().Put(ctx, client.ApiPath.ProjectImageFile(projectID, imageID), f, nil, opts)
+	return err
+}
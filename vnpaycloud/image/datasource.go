@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -61,15 +62,14 @@ func dataSourceImageRead(ctx context.Context, d *schema.ResourceData, meta inter
 		return setImageData(d, &resp.Image)
 	}
 
-	listResp := &dto.ListImagesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Images(cfg.ZoneID), listResp, nil)
+	images, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Images(cfg.ZoneID), func(r *dto.ListImagesResponse) []dto.Image { return r.Images })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_image: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, img := range listResp.Images {
+	for _, img := range images {
 		if nameOk && img.Name != nameFilter.(string) {
 			continue
 		}
@@ -94,6 +94,8 @@ func DataSourceImages() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceImagesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"images": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -116,14 +118,18 @@ func DataSourceImages() *schema.Resource {
 func dataSourceImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListImagesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Images(cfg.ZoneID), listResp, nil)
+	allImages, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Images(cfg.ZoneID), func(r *dto.ListImagesResponse) []dto.Image { return r.Images })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_images: %s", err)
 	}
 
+	allImages, err = util.ApplyDataSourceFilters(d, allImages, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_images: %s", err)
+	}
+
 	var images []map[string]interface{}
-	for _, img := range listResp.Images {
+	for _, img := range allImages {
 		images = append(images, map[string]interface{}{
 			"id":          img.ID,
 			"name":        img.Name,
@@ -0,0 +1,289 @@
+package image
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceImage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceImageCreate,
+		ReadContext:   resourceImageRead,
+		UpdateContext: resourceImageUpdate,
+		DeleteContext: resourceImageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"instance_id", "volume_id", "source_file", "source_url"},
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"disk_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"qcow2", "raw"}, false),
+			},
+			"checksum": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+			},
+			"os_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"min_disk_gb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"shared_project_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			// Computed attributes
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"visibility": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	createOpts := dto.CreateImageRequest{
+		Name:       d.Get("name").(string),
+		InstanceID: d.Get("instance_id").(string),
+		VolumeID:   d.Get("volume_id").(string),
+		SourceURL:  d.Get("source_url").(string),
+		DiskFormat: d.Get("disk_format").(string),
+		OsType:     d.Get("os_type").(string),
+		MinDiskGB:  int32(d.Get("min_disk_gb").(int)),
+	}
+
+	// Uploaded data must match the checksum, when one is given.
+	checksum := strings.ToLower(d.Get("checksum").(string))
+	sourceFile := d.Get("source_file").(string)
+	if sourceFile != "" {
+		fileChecksum, err := fileSHA256(sourceFile)
+		if err != nil {
+			return diag.Errorf("Error reading source_file of vnpaycloud_image: %s", err)
+		}
+		if checksum != "" && checksum != fileChecksum {
+			return diag.Errorf("Error creating vnpaycloud_image: checksum %s does not match the SHA-256 of %s (%s)", checksum, sourceFile, fileChecksum)
+		}
+		checksum = fileChecksum
+	}
+
+	tflog.Debug(ctx, "vnpaycloud_image create options", map[string]interface{}{"create_opts": createOpts})
+
+	createResp := &dto.ImageResponse{}
+	_, err := cfg.Client.Post(ctx, client.ApiPath.ProjectImages(cfg.ProjectID), createOpts, createResp, nil)
+	if err != nil {
+		return diag.Errorf("Error creating vnpaycloud_image: %s", err)
+	}
+
+	d.SetId(createResp.Image.ID)
+
+	if sourceFile != "" {
+		tflog.Debug(ctx, "Uploading vnpaycloud_image data", map[string]interface{}{"id": d.Id(), "source_file": sourceFile})
+
+		if err := uploadImageFile(ctx, cfg.Client, cfg.ProjectID, d.Id(), sourceFile); err != nil {
+			return diag.Errorf("Error uploading %s to vnpaycloud_image %s: %s", sourceFile, d.Id(), err)
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"queued", "saving", "creating", "importing", "uploading"},
+		Target:     []string{"active"},
+		Refresh:    imageStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, createResp.Image.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	raw, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for vnpaycloud_image %s to become active: %s", createResp.Image.ID, err)
+	}
+
+	if img := raw.(dto.Image); checksum != "" && img.Checksum != "" && !strings.EqualFold(img.Checksum, checksum) {
+		return diag.Errorf("Error verifying vnpaycloud_image %s: backend checksum %s does not match expected %s", d.Id(), img.Checksum, checksum)
+	}
+
+	if add, _ := diffImageMembers(nil, d.Get("shared_project_ids").(*schema.Set).List()); len(add) > 0 {
+		if err := updateImageMembers(ctx, cfg.Client, cfg.ProjectID, d.Id(), add, nil); err != nil {
+			return diag.Errorf("Error sharing vnpaycloud_image %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceImageRead(ctx, d, meta)
+}
+
+func resourceImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	imgResp := &dto.ImageResponse{}
+	_, err := cfg.Client.Get(ctx, client.ApiPath.ProjectImageWithID(cfg.ProjectID, d.Id()), imgResp, nil)
+	if err != nil {
+		return diag.FromErr(util.CheckNotFound(d, err, "Error retrieving vnpaycloud_image"))
+	}
+
+	tflog.Debug(ctx, "Retrieved vnpaycloud_image "+d.Id(), map[string]interface{}{"image": imgResp.Image})
+
+	img := imgResp.Image
+	d.Set("name", img.Name)
+	d.Set("os_type", img.OsType)
+	d.Set("min_disk_gb", img.MinDiskGB)
+	d.Set("status", img.Status)
+	d.Set("visibility", img.Visibility)
+	d.Set("size_bytes", img.SizeBytes)
+	if img.DiskFormat != "" {
+		d.Set("disk_format", img.DiskFormat)
+	}
+	if img.Checksum != "" {
+		d.Set("checksum", strings.ToLower(img.Checksum))
+	}
+	d.Set("created_at", img.CreatedAt)
+	if img.InstanceID != "" {
+		d.Set("instance_id", img.InstanceID)
+	}
+	if img.VolumeID != "" {
+		d.Set("volume_id", img.VolumeID)
+	}
+
+	members, err := client.ListAll(ctx, cfg.Client, client.ApiPath.ProjectImageMembers(cfg.ProjectID, d.Id()), func(r *dto.ListImageMembersResponse) []dto.ImageMember { return r.Members })
+	if err != nil {
+		return diag.Errorf("Error listing members of vnpaycloud_image %s: %s", d.Id(), err)
+	}
+
+	sharedProjectIDs := make([]string, 0, len(members))
+	for _, m := range members {
+		sharedProjectIDs = append(sharedProjectIDs, m.ProjectID)
+	}
+	d.Set("shared_project_ids", sharedProjectIDs)
+
+	return nil
+}
+
+func resourceImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	if d.HasChanges("name", "os_type", "min_disk_gb") {
+		updateOpts := dto.UpdateImageRequest{}
+
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+		if d.HasChange("os_type") {
+			updateOpts.OsType = d.Get("os_type").(string)
+		}
+		if d.HasChange("min_disk_gb") {
+			updateOpts.MinDiskGB = int32(d.Get("min_disk_gb").(int))
+		}
+
+		tflog.Debug(ctx, "vnpaycloud_image update options", map[string]interface{}{"update_opts": updateOpts})
+
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.ProjectImageWithID(cfg.ProjectID, d.Id()), updateOpts, nil, nil); err != nil {
+			return diag.Errorf("Error updating vnpaycloud_image %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("shared_project_ids") {
+		o, n := d.GetChange("shared_project_ids")
+		add, remove := diffImageMembers(o.(*schema.Set).List(), n.(*schema.Set).List())
+
+		tflog.Debug(ctx, "vnpaycloud_image update members", map[string]interface{}{"add": add, "remove": remove})
+
+		if err := updateImageMembers(ctx, cfg.Client, cfg.ProjectID, d.Id(), add, remove); err != nil {
+			return diag.Errorf("Error updating sharing of vnpaycloud_image %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceImageRead(ctx, d, meta)
+}
+
+func resourceImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	if _, err := cfg.Client.Delete(ctx, client.ApiPath.ProjectImageWithID(cfg.ProjectID, d.Id()), nil); err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vnpaycloud_image"))
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"active", "deleting", "pending_delete"},
+		Target:     []string{"deleted"},
+		Refresh:    imageStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for vnpaycloud_image %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,457 @@
+package image
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testProjectImage() dto.Image {
+	return dto.Image{
+		ID:         "img-001",
+		Name:       "golden-web",
+		OsType:     "linux",
+		MinDiskGB:  20,
+		Status:     "active",
+		Visibility: "private",
+		SizeBytes:  2147483648,
+		InstanceID: "inst-001",
+		CreatedAt:  "2025-01-15T10:00:00Z",
+	}
+}
+
+func TestResourceImageCreate_FromInstance(t *testing.T) {
+	img := testProjectImage()
+
+	var createBody dto.CreateImageRequest
+	var sharedWith []string
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: client.ApiPath.ProjectImages(testhelpers.TestProjectID),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&createBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.ImageResponse{Image: img})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ProjectImageWithID(testhelpers.TestProjectID, "img-001"),
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ImageResponse{Image: img}),
+		},
+		{
+			Pattern: client.ApiPath.ProjectImageMembers(testhelpers.TestProjectID, "img-001"),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					var body dto.AddImageMemberRequest
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("failed to decode request body: %v", err)
+					}
+					sharedWith = append(sharedWith, body.ProjectID)
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				members := []dto.ImageMember{}
+				for _, p := range sharedWith {
+					members = append(members, dto.ImageMember{ProjectID: p, Status: "accepted"})
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.ListImageMembersResponse{Members: members})(w, r)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceImage()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":               "golden-web",
+		"instance_id":        "inst-001",
+		"os_type":            "linux",
+		"min_disk_gb":        20,
+		"shared_project_ids": []interface{}{"proj-other"},
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "img-001" {
+		t.Errorf("expected ID img-001, got %s", d.Id())
+	}
+	if createBody.InstanceID != "inst-001" || createBody.VolumeID != "" {
+		t.Errorf("expected image created from inst-001, got %+v", createBody)
+	}
+	if createBody.MinDiskGB != 20 || createBody.OsType != "linux" {
+		t.Errorf("expected os_type and min_disk_gb in create request, got %+v", createBody)
+	}
+	if strings.Join(sharedWith, ",") != "proj-other" {
+		t.Errorf("expected image shared with proj-other, got %v", sharedWith)
+	}
+	if v := d.Get("status").(string); v != "active" {
+		t.Errorf("expected status active, got %s", v)
+	}
+	if v := d.Get("size_bytes").(int); v != 2147483648 {
+		t.Errorf("expected size_bytes 2147483648, got %d", v)
+	}
+	if v := d.Get("shared_project_ids").(*schema.Set); v.Len() != 1 || !v.Contains("proj-other") {
+		t.Errorf("expected shared_project_ids [proj-other], got %v", v.List())
+	}
+}
+
+func TestResourceImageRead_NotFound(t *testing.T) {
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ProjectImageWithID(testhelpers.TestProjectID, "img-gone"),
+			Handler: testhelpers.EmptyHandler(http.StatusNotFound),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceImage()
+	d := res.TestResourceData()
+	d.SetId("img-gone")
+
+	diags := res.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected ID to be cleared, got %s", d.Id())
+	}
+}
+
+func TestResourceImageUpdate_Sharing(t *testing.T) {
+	img := testProjectImage()
+
+	var added []string
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ProjectImageWithID(testhelpers.TestProjectID, "img-001"),
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ImageResponse{Image: img}),
+		},
+		{
+			Pattern: client.ApiPath.ProjectImageMembers(testhelpers.TestProjectID, "img-001"),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					var body dto.AddImageMemberRequest
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("failed to decode request body: %v", err)
+					}
+					added = append(added, body.ProjectID)
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.ListImageMembersResponse{Members: []dto.ImageMember{
+					{ProjectID: "proj-b"}, {ProjectID: "proj-c"},
+				}})(w, r)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceImage()
+	is := &terraform.InstanceState{
+		ID: "img-001",
+		Attributes: map[string]string{
+			"name":                 "golden-web",
+			"instance_id":          "inst-001",
+			"os_type":              "linux",
+			"min_disk_gb":          "20",
+			"shared_project_ids.#": "1",
+			"shared_project_ids." + strconv.Itoa(schema.HashString("proj-b")): "proj-b",
+		},
+	}
+	raw := map[string]interface{}{
+		"name":               "golden-web",
+		"instance_id":        "inst-001",
+		"os_type":            "linux",
+		"min_disk_gb":        20,
+		"shared_project_ids": []interface{}{"proj-b", "proj-c"},
+	}
+	diff, err := res.Diff(context.Background(), is, terraform.NewResourceConfigRaw(raw), cfg)
+	if err != nil {
+		t.Fatalf("unexpected diff error: %v", err)
+	}
+	d, err := schema.InternalMap(res.Schema).Data(is, diff)
+	if err != nil {
+		t.Fatalf("unexpected error building resource data: %v", err)
+	}
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if strings.Join(added, ",") != "proj-c" {
+		t.Errorf("expected proj-c to be added, got %v", added)
+	}
+	if v := d.Get("shared_project_ids").(*schema.Set); v.Len() != 2 {
+		t.Errorf("expected 2 shared projects, got %v", v.List())
+	}
+}
+
+func TestDiffImageMembers(t *testing.T) {
+	add, remove := diffImageMembers(
+		[]interface{}{"proj-a", "proj-b"},
+		[]interface{}{"proj-b", "proj-c"},
+	)
+
+	if strings.Join(add, ",") != "proj-c" {
+		t.Errorf("expected proj-c to be added, got %v", add)
+	}
+	if strings.Join(remove, ",") != "proj-a" {
+		t.Errorf("expected proj-a to be removed, got %v", remove)
+	}
+}
+
+func TestUpdateImageMembers_RemoveIgnoresNotFound(t *testing.T) {
+	var removed []string
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "DELETE",
+			Pattern: client.ApiPath.ProjectImageMemberWithID(testhelpers.TestProjectID, "img-001", "proj-a"),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				removed = append(removed, "proj-a")
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	// proj-gone has no route, so the mock server answers 404.
+	err := updateImageMembers(context.Background(), cfg.Client, cfg.ProjectID, "img-001", nil, []string{"proj-a", "proj-gone"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(removed, ",") != "proj-a" {
+		t.Errorf("expected proj-a to be removed, got %v", removed)
+	}
+}
+
+func TestResourceImageDelete(t *testing.T) {
+	deleted := false
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Pattern: client.ApiPath.ProjectImageWithID(testhelpers.TestProjectID, "img-001"),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					deleted = true
+					w.WriteHeader(http.StatusOK)
+					return
+				}
+				w.WriteHeader(http.StatusNotFound)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceImage()
+	d := res.TestResourceData()
+	d.SetId("img-001")
+
+	diags := res.DeleteContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !deleted {
+		t.Error("expected DELETE to be called")
+	}
+}
+
+func TestResourceImageSchema_SourceExactlyOne(t *testing.T) {
+	res := ResourceImage()
+	diags := res.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":        "golden-web",
+		"instance_id": "inst-001",
+		"volume_id":   "vol-001",
+	}))
+	if !diags.HasError() {
+		t.Fatal("expected error when both instance_id and volume_id are set, got none")
+	}
+}
+
+func TestResourceImageCreate_UploadFile(t *testing.T) {
+	data := []byte("QFI\xfb fake qcow2 image data")
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+
+	path := filepath.Join(t.TempDir(), "disk.qcow2")
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatalf("failed to write image file: %v", err)
+	}
+
+	img := testProjectImage()
+	img.InstanceID = ""
+	img.DiskFormat = "qcow2"
+	img.Checksum = checksum
+
+	var createBody dto.CreateImageRequest
+	var uploaded []byte
+	var uploadContentType string
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: client.ApiPath.ProjectImages(testhelpers.TestProjectID),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&createBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				queued := img
+				queued.Status = "queued"
+				testhelpers.JSONHandler(t, http.StatusOK, dto.ImageResponse{Image: queued})(w, r)
+			},
+		},
+		{
+			Method:  "PUT",
+			Pattern: client.ApiPath.ProjectImageFile(testhelpers.TestProjectID, "img-001"),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				uploadContentType = r.Header.Get("Content-Type")
+				uploaded, _ = io.ReadAll(r.Body)
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ProjectImageWithID(testhelpers.TestProjectID, "img-001"),
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ImageResponse{Image: img}),
+		},
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ProjectImageMembers(testhelpers.TestProjectID, "img-001"),
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListImageMembersResponse{}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceImage()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":        "golden-web",
+		"source_file": path,
+		"disk_format": "qcow2",
+		"os_type":     "linux",
+		"min_disk_gb": 20,
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if createBody.DiskFormat != "qcow2" || createBody.SourceURL != "" {
+		t.Errorf("unexpected create request %+v", createBody)
+	}
+	if string(uploaded) != string(data) {
+		t.Errorf("expected the file to be uploaded, got %q", uploaded)
+	}
+	if uploadContentType != "application/octet-stream" {
+		t.Errorf("expected application/octet-stream upload, got %s", uploadContentType)
+	}
+	if v := d.Get("checksum").(string); v != checksum {
+		t.Errorf("expected checksum %s, got %s", checksum, v)
+	}
+}
+
+func TestResourceImageCreate_ChecksumMismatch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "disk.raw")
+	if err := os.WriteFile(path, []byte("raw image data"), 0o600); err != nil {
+		t.Fatalf("failed to write image file: %v", err)
+	}
+
+	// No routes: the mismatch must be caught before anything is created.
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceImage()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":        "golden-web",
+		"source_file": path,
+		"disk_format": "raw",
+		"checksum":    strings.Repeat("0", 64),
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if !diags.HasError() {
+		t.Fatal("expected checksum mismatch error, got none")
+	}
+	if !strings.Contains(diags[0].Summary, "does not match the SHA-256") {
+		t.Errorf("expected checksum mismatch error, got: %s", diags[0].Summary)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no image to be created, got ID %s", d.Id())
+	}
+}
+
+func TestResourceImageCreate_FromURL(t *testing.T) {
+	img := testProjectImage()
+	img.InstanceID = ""
+	img.DiskFormat = "raw"
+
+	var createBody dto.CreateImageRequest
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: client.ApiPath.ProjectImages(testhelpers.TestProjectID),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&createBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.ImageResponse{Image: img})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ProjectImageWithID(testhelpers.TestProjectID, "img-001"),
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ImageResponse{Image: img}),
+		},
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ProjectImageMembers(testhelpers.TestProjectID, "img-001"),
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListImageMembersResponse{}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceImage()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":        "golden-web",
+		"source_url":  "https://images.example.com/debian-12.raw",
+		"disk_format": "raw",
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if createBody.SourceURL != "https://images.example.com/debian-12.raw" {
+		t.Errorf("expected sourceUrl in create request, got %+v", createBody)
+	}
+	if v := d.Get("disk_format").(string); v != "raw" {
+		t.Errorf("expected disk_format raw, got %s", v)
+	}
+}
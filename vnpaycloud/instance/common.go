@@ -2,11 +2,14 @@ package instance
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
 	"terraform-provider-vnpaycloud/vnpaycloud/util"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
@@ -31,3 +34,202 @@ func instanceStateRefreshFunc(ctx context.Context, c *client.Client, projectID,
 		return instResp.Instance, instResp.Instance.Status, nil
 	}
 }
+
+// consoleTailLines is the number of console lines appended to instance
+// waiter timeout errors.
+const consoleTailLines = 50
+
+// waitForInstanceState runs stateConf and, when it times out, appends the tail
+// of the instance console output to the error so a stuck boot can be
+// diagnosed from the Terraform output alone.
+func waitForInstanceState(ctx context.Context, c *client.Client, projectID, instanceID string, stateConf *retry.StateChangeConf) error {
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	var timeoutErr *retry.TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		return err
+	}
+
+	// The operation context usually expires together with the waiter.
+	tailCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+
+	tail, tailErr := getInstanceConsoleOutput(tailCtx, c, projectID, instanceID, consoleTailLines)
+	if tailErr != nil || strings.TrimSpace(tail) == "" {
+		return err
+	}
+
+	return fmt.Errorf("%w\n\nLast %d lines of the console output:\n%s", err, consoleTailLines, tail)
+}
+
+// getInstanceConsoleOutput returns the last lines of the instance serial
+// console log. A non-positive lines returns the whole log.
+func getInstanceConsoleOutput(ctx context.Context, c *client.Client, projectID, instanceID string, lines int) (string, error) {
+	path := client.ApiPath.InstanceConsoleOutput(projectID, instanceID)
+	if lines > 0 {
+		path += fmt.Sprintf("?length=%d", lines)
+	}
+
+	resp := &dto.InstanceConsoleOutputResponse{}
+	if _, err := c.Get(ctx, path, resp, nil); err != nil {
+		return "", err
+	}
+
+	return tailLines(resp.Output, lines), nil
+}
+
+// tailLines returns the last n lines of s, ignoring a trailing newline.
+func tailLines(s string, n int) string {
+	s = strings.TrimRight(s, "\n")
+	if n <= 0 || s == "" {
+		return s
+	}
+
+	lines := strings.Split(s, "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Power states accepted by the power_state argument.
+const (
+	powerStateRunning = "running"
+	powerStateShutoff = "shutoff"
+)
+
+// normalizePowerState maps the backend power state (e.g. "Running",
+// "Shutdown") onto the values accepted by power_state.
+func normalizePowerState(state string) string {
+	switch strings.ToLower(state) {
+	case "running", "active":
+		return powerStateRunning
+	case "shutoff", "shutdown", "stopped":
+		return powerStateShutoff
+	}
+	return strings.ToLower(state)
+}
+
+// setInstancePowerState starts or stops the instance and waits until it
+// reaches the requested power state.
+func setInstancePowerState(ctx context.Context, c *client.Client, projectID, instanceID, powerState string, timeout time.Duration) error {
+	path := client.ApiPath.InstanceStart(projectID, instanceID)
+	pending := []string{"starting", "powering-on", "shutoff", "stopped"}
+	target := []string{"active", "running"}
+	if powerState == powerStateShutoff {
+		path = client.ApiPath.InstanceStop(projectID, instanceID)
+		pending = []string{"stopping", "powering-off", "active", "running"}
+		target = []string{"shutoff", "stopped"}
+	}
+
+	if _, err := c.Post(ctx, path, nil, nil, nil); err != nil {
+		return err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    instanceStateRefreshFunc(ctx, c, projectID, instanceID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return waitForInstanceState(ctx, c, projectID, instanceID, stateConf)
+}
+
+// rebootInstance reboots the instance and waits until it is active again.
+func rebootInstance(ctx context.Context, c *client.Client, projectID, instanceID, rebootType string, timeout time.Duration) error {
+	rebootOpts := dto.RebootInstanceRequest{Type: rebootType}
+	if _, err := c.Post(ctx, client.ApiPath.InstanceReboot(projectID, instanceID), rebootOpts, nil, nil); err != nil {
+		return err
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"reboot", "rebooting", "hard_reboot", "hard-rebooting"},
+		Target:     []string{"active", "running"},
+		Refresh:    instanceStateRefreshFunc(ctx, c, projectID, instanceID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	return waitForInstanceState(ctx, c, projectID, instanceID, stateConf)
+}
+
+// findInstanceRootVolume returns the ID of the instance's boot volume: the
+// first bootable volume attached to it. It fails when none is flagged
+// bootable rather than risk resizing or retyping a data volume.
+func findInstanceRootVolume(ctx context.Context, c *client.Client, projectID, instanceID string) (string, error) {
+	instResp := &dto.InstanceResponse{}
+	if _, err := c.Get(ctx, client.ApiPath.InstanceWithID(projectID, instanceID), instResp, nil); err != nil {
+		return "", err
+	}
+
+	volumeIDs := instResp.Instance.VolumeIDs
+	if len(volumeIDs) == 0 {
+		return "", fmt.Errorf("instance %s has no volumes attached", instanceID)
+	}
+
+	for _, volumeID := range volumeIDs {
+		volResp := &dto.VolumeResponse{}
+		if _, err := c.Get(ctx, client.ApiPath.VolumeWithID(projectID, volumeID), volResp, nil); err != nil {
+			return "", err
+		}
+		if volResp.Volume.IsBootable {
+			return volumeID, nil
+		}
+	}
+
+	return "", fmt.Errorf("no bootable root volume found for instance %s", instanceID)
+}
+
+func rootVolumeStateRefreshFunc(ctx context.Context, c *client.Client, projectID, volumeID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		volResp := &dto.VolumeResponse{}
+		if _, err := c.Get(ctx, client.ApiPath.VolumeWithID(projectID, volumeID), volResp, nil); err != nil {
+			return nil, "", err
+		}
+
+		if volResp.Volume.Status == "failed" || volResp.Volume.Status == "error" {
+			return volResp.Volume, volResp.Volume.Status, fmt.Errorf("The root volume is in error status. " +
+				"Please check with your cloud admin or check the API logs.")
+		}
+
+		return volResp.Volume, volResp.Volume.Status, nil
+	}
+}
+
+// findFlavorByName returns the flavor with the given name in the zone, or nil
+// when there is none.
+func findFlavorByName(ctx context.Context, c *client.Client, zoneID, name string) (*dto.Flavor, error) {
+	flavors, err := client.ListAll(ctx, c, client.ApiPath.Flavors(zoneID), func(r *dto.ListFlavorsResponse) []dto.Flavor { return r.Flavors })
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range flavors {
+		if f.Name == name {
+			return &f, nil
+		}
+	}
+	return nil, nil
+}
+
+// findImageByNameOrID returns the image with the given name or ID from the
+// zone catalogue or the project's private images, or nil when there is none.
+func findImageByNameOrID(ctx context.Context, c *client.Client, projectID, zoneID, nameOrID string) (*dto.Image, error) {
+	for _, path := range []string{client.ApiPath.Images(zoneID), client.ApiPath.ProjectImages(projectID)} {
+		images, err := client.ListAll(ctx, c, path, func(r *dto.ListImagesResponse) []dto.Image { return r.Images })
+		if err != nil {
+			return nil, err
+		}
+
+		for _, img := range images {
+			if img.Name == nameOrID || img.ID == nameOrID {
+				return &img, nil
+			}
+		}
+	}
+	return nil, nil
+}
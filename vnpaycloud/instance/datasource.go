@@ -3,9 +3,11 @@ package instance
 import (
 	"context"
 	"fmt"
+	"slices"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -92,15 +94,14 @@ func dataSourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta in
 		return setInstanceData(d, &instResp.Instance)
 	}
 
-	listResp := &dto.ListInstancesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Instances(cfg.ProjectID), listResp, nil)
+	instances, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Instances(cfg.ProjectID), func(r *dto.ListInstancesResponse) []dto.Instance { return r.Instances })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_instance: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, inst := range listResp.Instances {
+	for _, inst := range instances {
 		if nameOk && inst.Name != nameFilter.(string) {
 			continue
 		}
@@ -132,6 +133,8 @@ func DataSourceInstances() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceInstancesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"instances": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -157,14 +160,43 @@ func DataSourceInstances() *schema.Resource {
 func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListInstancesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Instances(cfg.ProjectID), listResp, nil)
+	filters, nameRegex := util.DataSourceFilters(d)
+
+	listOpts := dto.ListInstancesOpts{}
+	util.SetFilterQuery(&listOpts, filters)
+	listPath, err := client.WithQuery(client.ApiPath.Instances(cfg.ProjectID), listOpts)
+	if err != nil {
+		return diag.Errorf("Error listing vnpaycloud_instances: %s", err)
+	}
+
+	allInstances, err := client.ListAll(ctx, cfg.Client, listPath, func(r *dto.ListInstancesResponse) []dto.Instance { return r.Instances })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_instances: %s", err)
 	}
 
+	items := make([]instanceFilterItem, len(allInstances))
+	for i, inst := range allInstances {
+		items[i].Instance = inst
+	}
+
+	if util.HasFilter(filters, "vpc_id") {
+		nicVpcIDs, err := networkInterfaceVpcIDs(ctx, cfg)
+		if err != nil {
+			return diag.Errorf("Error resolving the VPCs of vnpaycloud_instances: %s", err)
+		}
+		for i := range items {
+			items[i].VpcIDs = instanceVpcIDs(items[i].Instance, nicVpcIDs)
+		}
+	}
+
+	items, err = util.FilterItems(items, filters, "name", nameRegex)
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_instances: %s", err)
+	}
+
 	var instances []map[string]interface{}
-	for _, inst := range listResp.Instances {
+	for _, item := range items {
+		inst := item.Instance
 		instances = append(instances, map[string]interface{}{
 			"id":              inst.ID,
 			"name":            inst.Name,
@@ -184,3 +216,47 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	return nil
 }
+
+// instanceFilterItem is an instance with the attributes it can be filtered
+// on that the API does not return with it.
+type instanceFilterItem struct {
+	dto.Instance
+	VpcIDs []string `json:"vpcId"`
+}
+
+// networkInterfaceVpcIDs maps the ID of every network interface in the
+// project to the VPC of its subnet.
+func networkInterfaceVpcIDs(ctx context.Context, cfg *config.Config) (map[string]string, error) {
+	subnets, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Subnets(cfg.ProjectID), func(r *dto.ListSubnetsResponse) []dto.Subnet { return r.Subnets })
+	if err != nil {
+		return nil, err
+	}
+	subnetVpcIDs := make(map[string]string, len(subnets))
+	for _, s := range subnets {
+		subnetVpcIDs[s.ID] = s.VpcID
+	}
+
+	nics, err := client.ListAll(ctx, cfg.Client, client.ApiPath.NetworkInterfaces(cfg.ProjectID), func(r *dto.ListNetworkInterfacesResponse) []dto.NetworkInterface { return r.NetworkInterfaces })
+	if err != nil {
+		return nil, err
+	}
+	nicVpcIDs := make(map[string]string, len(nics))
+	for _, nic := range nics {
+		if vpcID := subnetVpcIDs[nic.SubnetID]; vpcID != "" {
+			nicVpcIDs[nic.ID] = vpcID
+		}
+	}
+
+	return nicVpcIDs, nil
+}
+
+// instanceVpcIDs returns the VPCs the network interfaces of inst are in.
+func instanceVpcIDs(inst dto.Instance, nicVpcIDs map[string]string) []string {
+	var vpcIDs []string
+	for _, nicID := range inst.NetworkInterfaceIDs {
+		if vpcID, ok := nicVpcIDs[nicID]; ok && !slices.Contains(vpcIDs, vpcID) {
+			vpcIDs = append(vpcIDs, vpcID)
+		}
+	}
+	return vpcIDs
+}
@@ -0,0 +1,54 @@
+package instance
+
+import (
+	"context"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceInstanceConsoleOutput exposes the tail of an instance serial
+// console log via GET /v2/iac/projects/{project_id}/instances/{id}/console-output.
+func DataSourceInstanceConsoleOutput() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstanceConsoleOutputRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the instance to fetch the console output for.",
+			},
+			"lines": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      50,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of lines to return from the end of the console log.",
+			},
+			"output": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last `lines` lines of the serial console log.",
+			},
+		},
+	}
+}
+
+func dataSourceInstanceConsoleOutputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	instanceID := d.Get("instance_id").(string)
+
+	output, err := getInstanceConsoleOutput(ctx, cfg.Client, cfg.ProjectID, instanceID, d.Get("lines").(int))
+	if err != nil {
+		return diag.Errorf("Error fetching console output for vnpaycloud_instance %s: %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+	d.Set("output", output)
+
+	return nil
+}
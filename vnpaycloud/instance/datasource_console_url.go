@@ -0,0 +1,62 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DataSourceInstanceConsoleURL exposes a remote console URL of an instance
+// via GET /v2/iac/projects/{project_id}/instances/{id}/console-url.
+func DataSourceInstanceConsoleURL() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceInstanceConsoleURLRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the instance to open a console for.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "novnc",
+				ValidateFunc: validation.StringInSlice([]string{"novnc", "serial"}, false),
+				Description:  "Console type: `novnc` (browser VNC console) or `serial` (websocket serial console).",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The console URL. It embeds a short-lived access token.",
+			},
+		},
+	}
+}
+
+func dataSourceInstanceConsoleURLRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	instanceID := d.Get("instance_id").(string)
+	consoleType := d.Get("type").(string)
+
+	path := client.ApiPath.InstanceConsoleURL(cfg.ProjectID, instanceID) + "?type=" + consoleType
+
+	resp := &dto.InstanceConsoleURLResponse{}
+	_, err := cfg.Client.Get(ctx, path, resp, nil)
+	if err != nil {
+		return diag.Errorf("Error fetching console URL for vnpaycloud_instance %s: %s", instanceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, consoleType))
+	d.Set("url", resp.Console.URL)
+
+	return nil
+}
@@ -3,6 +3,7 @@ package instance
 import (
 	"context"
 	"net/http"
+	"strings"
 	"testing"
 
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
@@ -178,3 +179,268 @@ func TestDataSourceInstancesRead(t *testing.T) {
 		t.Errorf("expected second instance name test-instance-2, got %v", second["name"])
 	}
 }
+
+func TestDataSourceInstancesRead_FollowsPageToken(t *testing.T) {
+	first := testInstance()
+	second := testInstance()
+	second.ID = "inst-002"
+	second.Name = "test-instance-2"
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("pageToken") == "page-2" {
+					testhelpers.JSONHandler(t, http.StatusOK, dto.ListInstancesResponse{
+						Instances: []dto.Instance{second},
+					})(w, r)
+					return
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, map[string]interface{}{
+					"instances":     []dto.Instance{first},
+					"nextPageToken": "page-2",
+				})(w, r)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	ds := DataSourceInstances()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{})
+
+	diags := ds.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	instances := d.Get("instances").([]interface{})
+	if len(instances) != 2 {
+		t.Fatalf("expected 2 instances across pages, got %d", len(instances))
+	}
+	if id := instances[1].(map[string]interface{})["id"].(string); id != "inst-002" {
+		t.Errorf("expected second instance inst-002, got %s", id)
+	}
+}
+
+func TestDataSourceInstancesRead_Filter(t *testing.T) {
+	web := testInstance()
+	web.FlavorName = "c2.small"
+	stopped := testInstance()
+	stopped.ID = "inst-002"
+	stopped.FlavorName = "c2.large"
+	stopped.Status = "stopped"
+	db := testInstance()
+	db.ID = "inst-003"
+	db.Name = "db-instance"
+	db.FlavorName = "m1.large"
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListInstancesResponse{
+				Instances: []dto.Instance{web, stopped, db},
+			}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	ds := DataSourceInstances()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"name_regex": "^test-",
+		"filter": []interface{}{
+			map[string]interface{}{"name": "status", "values": []interface{}{"active"}},
+			map[string]interface{}{"name": "flavor_name", "values": []interface{}{"c2.*"}},
+		},
+	})
+
+	diags := ds.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	instances := d.Get("instances").([]interface{})
+	if len(instances) != 1 {
+		t.Fatalf("expected 1 filtered instance, got %d", len(instances))
+	}
+	if id := instances[0].(map[string]interface{})["id"].(string); id != "inst-001" {
+		t.Errorf("expected inst-001, got %s", id)
+	}
+}
+
+func TestDataSourceInstancesRead_FilterByVPC(t *testing.T) {
+	web := testInstance()
+	web.FlavorName = "c2.small"
+	web.PowerState = "running"
+	web.NetworkInterfaceIDs = []string{"nic-001"}
+	other := testInstance()
+	other.ID = "inst-002"
+	other.FlavorName = "c2.large"
+	other.PowerState = "running"
+	other.NetworkInterfaceIDs = []string{"nic-002"}
+	off := testInstance()
+	off.ID = "inst-003"
+	off.FlavorName = "c2.small"
+	off.PowerState = "shutdown"
+	off.NetworkInterfaceIDs = []string{"nic-003"}
+
+	var query string
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.RawQuery
+				testhelpers.JSONHandler(t, http.StatusOK, dto.ListInstancesResponse{
+					Instances: []dto.Instance{web, other, off},
+				})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/subnets",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListSubnetsResponse{
+				Subnets: []dto.Subnet{{ID: "subnet-a", VpcID: "vpc-a"}, {ID: "subnet-b", VpcID: "vpc-b"}},
+			}),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/network-interfaces",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListNetworkInterfacesResponse{
+				NetworkInterfaces: []dto.NetworkInterface{
+					{ID: "nic-001", SubnetID: "subnet-a"},
+					{ID: "nic-002", SubnetID: "subnet-b"},
+					{ID: "nic-003", SubnetID: "subnet-a"},
+				},
+			}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	ds := DataSourceInstances()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "power_state", "values": []interface{}{"Running"}},
+			map[string]interface{}{"name": "vpc_id", "values": []interface{}{"vpc-a"}},
+			map[string]interface{}{"name": "flavor_name", "values": []interface{}{"c2.*"}},
+			map[string]interface{}{"name": "zone_id", "values": []interface{}{testhelpers.TestZoneID}},
+		},
+	})
+
+	diags := ds.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if query != "zoneId="+testhelpers.TestZoneID {
+		t.Errorf("expected the zone filter to be sent as a query parameter, got %q", query)
+	}
+	instances := d.Get("instances").([]interface{})
+	if len(instances) != 1 {
+		t.Fatalf("expected 1 filtered instance, got %d", len(instances))
+	}
+	if id := instances[0].(map[string]interface{})["id"].(string); id != "inst-001" {
+		t.Errorf("expected inst-001, got %s", id)
+	}
+}
+
+func TestDataSourceInstancesRead_UnknownFilter(t *testing.T) {
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListInstancesResponse{}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	ds := DataSourceInstances()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"filter": []interface{}{
+			map[string]interface{}{"name": "colour", "values": []interface{}{"red"}},
+		},
+	})
+
+	diags := ds.ReadContext(context.Background(), d, cfg)
+	if !diags.HasError() {
+		t.Fatal("expected error for unknown filter name")
+	}
+}
+
+func TestDataSourceInstanceConsoleOutputRead(t *testing.T) {
+	var gotLength string
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001/console-output",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				gotLength = r.URL.Query().Get("length")
+				testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceConsoleOutputResponse{
+					Output: "line 1\nline 2\nline 3\nline 4\n",
+				})(w, r)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	ds := DataSourceInstanceConsoleOutput()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"instance_id": "inst-001",
+		"lines":       2,
+	})
+
+	diags := ds.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if gotLength != "2" {
+		t.Errorf("expected length=2 query parameter, got %q", gotLength)
+	}
+	if d.Id() != "inst-001" {
+		t.Errorf("expected ID inst-001, got %s", d.Id())
+	}
+	if v := d.Get("output").(string); v != "line 3\nline 4" {
+		t.Errorf("expected the last 2 lines, got %q", v)
+	}
+}
+
+func TestDataSourceInstanceConsoleURLRead(t *testing.T) {
+	var gotType string
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001/console-url",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				gotType = r.URL.Query().Get("type")
+				testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceConsoleURLResponse{
+					Console: dto.InstanceConsole{Type: "novnc", URL: "https://console.example/vnc_auto.html?token=abc"},
+				})(w, r)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	ds := DataSourceInstanceConsoleURL()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"instance_id": "inst-001",
+	})
+
+	diags := ds.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if gotType != "novnc" {
+		t.Errorf("expected type=novnc query parameter, got %q", gotType)
+	}
+	if d.Id() != "inst-001/novnc" {
+		t.Errorf("expected ID inst-001/novnc, got %s", d.Id())
+	}
+	if v := d.Get("url").(string); !strings.HasPrefix(v, "https://console.example/") {
+		t.Errorf("expected console url, got %s", v)
+	}
+}
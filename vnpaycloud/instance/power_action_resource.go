@@ -0,0 +1,97 @@
+package instance
+
+import (
+	"context"
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Actions accepted by vnpaycloud_instance_power_action.
+const (
+	powerActionReboot     = "reboot"
+	powerActionHardReboot = "hard_reboot"
+)
+
+// ResourceInstancePowerAction performs a one-off power action on an instance
+// every time the resource is created, e.g. when one of its triggers changes.
+func ResourceInstancePowerAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceInstancePowerActionCreate,
+		ReadContext:   resourceInstancePowerActionRead,
+		DeleteContext: resourceInstancePowerActionDelete,
+		Description:   "Reboots a VNPAY Cloud instance whenever the resource is created or its triggers change.",
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the instance to act on.",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      powerActionReboot,
+				ValidateFunc: validation.StringInSlice([]string{powerActionReboot, powerActionHardReboot}, false),
+				Description:  "The power action to perform: `reboot` (graceful) or `hard_reboot`.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that cause the action to run again when they change.",
+			},
+		},
+	}
+}
+
+func resourceInstancePowerActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	instanceID := d.Get("instance_id").(string)
+	action := d.Get("action").(string)
+
+	rebootType := "SOFT"
+	if action == powerActionHardReboot {
+		rebootType = "HARD"
+	}
+
+	tflog.Debug(ctx, "vnpaycloud_instance_power_action create", map[string]interface{}{"instance_id": instanceID, "action": action})
+
+	if err := rebootInstance(ctx, cfg.Client, cfg.ProjectID, instanceID, rebootType, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("Error performing %s on vnpaycloud_instance %s: %s", action, instanceID, err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	return resourceInstancePowerActionRead(ctx, d, meta)
+}
+
+func resourceInstancePowerActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	instanceID := d.Get("instance_id").(string)
+
+	// The action itself leaves nothing to read; drop it once the instance is gone.
+	instResp := &dto.InstanceResponse{}
+	if _, err := cfg.Client.Get(ctx, client.ApiPath.InstanceWithID(cfg.ProjectID, instanceID), instResp, nil); err != nil {
+		return diag.FromErr(util.CheckNotFound(d, err, "Error retrieving instance for vnpaycloud_instance_power_action"))
+	}
+
+	return nil
+}
+
+func resourceInstancePowerActionDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
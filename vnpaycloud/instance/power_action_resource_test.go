@@ -0,0 +1,82 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceInstancePowerActionCreate(t *testing.T) {
+	inst := testInstance()
+	var rebootBody dto.RebootInstanceRequest
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001/reboot",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&rebootBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceInstancePowerAction()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"instance_id": "inst-001",
+		"action":      "hard_reboot",
+		"triggers":    map[string]interface{}{"config_hash": "abc"},
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if rebootBody.Type != "HARD" {
+		t.Errorf("expected reboot type HARD, got %q", rebootBody.Type)
+	}
+	if d.Id() == "" {
+		t.Error("expected ID to be set")
+	}
+}
+
+func TestResourceInstancePowerActionRead_InstanceGone(t *testing.T) {
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001",
+			Handler: testhelpers.EmptyHandler(http.StatusNotFound),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceInstancePowerAction()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"instance_id": "inst-001",
+	})
+	d.SetId("action-001")
+
+	diags := res.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected ID to be cleared when the instance is gone, got %s", d.Id())
+	}
+}
@@ -2,6 +2,7 @@ package instance
 
 import (
 	"context"
+	"fmt"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceInstance() *schema.Resource {
@@ -23,6 +25,7 @@ func ResourceInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceInstanceCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -36,7 +39,11 @@ func ResourceInstance() *schema.Resource {
 			"image": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+			},
+			"rebuild_on_image_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"snapshot_id": {
 				Type:     schema.TypeString,
@@ -62,18 +69,15 @@ func ResourceInstance() *schema.Resource {
 			"root_disk_gb": {
 				Type:     schema.TypeInt,
 				Required: true,
-				ForceNew: true,
 			},
 			"root_disk_type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 			"key_pair": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"security_groups": {
 				Type:     schema.TypeList,
@@ -90,18 +94,20 @@ func ResourceInstance() *schema.Resource {
 			"server_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
 			},
 			"user_data": {
 				Type:      schema.TypeString,
 				Optional:  true,
-				ForceNew:  true,
 				Sensitive: true,
 			},
 			"is_user_data_base64": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+			},
+			"user_data_replace_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			// Computed attributes
 			"image_name": {
@@ -126,13 +132,17 @@ func ResourceInstance() *schema.Resource {
 				Computed: true,
 			},
 			"power_state": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{powerStateRunning, powerStateShutoff}, false),
 			},
 			"zone_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     util.TagsSchema(),
+			"tags_all": util.TagsAllSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -149,12 +159,16 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		Image:              d.Get("image").(string),
 		SnapshotID:         d.Get("snapshot_id").(string),
 		Flavor:             d.Get("flavor").(string),
+		IsCustomFlavor:     d.Get("is_custom_flavor").(bool),
+		CustomVCPUs:        int32(d.Get("custom_vcpus").(int)),
+		CustomRAMMB:        int32(d.Get("custom_ram_mb").(int)),
 		RootDiskGB:         int32(d.Get("root_disk_gb").(int)),
 		RootDiskVolumeType: d.Get("root_disk_type").(string),
 		KeyPair:            d.Get("key_pair").(string),
 		ServerGroupID:      d.Get("server_group_id").(string),
 		UserData:           d.Get("user_data").(string),
 		IsUserDataBase64:   d.Get("is_user_data_base64").(bool),
+		Tags:               util.GetTagsAll(d, meta),
 	}
 
 	if v, ok := d.GetOk("network_interface_ids"); ok {
@@ -185,11 +199,19 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 		MinTimeout: 5 * time.Second,
 	}
 
-	_, err = stateConf.WaitForStateContext(ctx)
+	err = waitForInstanceState(ctx, cfg.Client, cfg.ProjectID, createResp.Instance.ID, stateConf)
 	if err != nil {
 		return diag.Errorf("Error waiting for vnpaycloud_instance %s to become ready: %s", createResp.Instance.ID, err)
 	}
 
+	if d.Get("power_state").(string) == powerStateShutoff {
+		tflog.Debug(ctx, "Stopping vnpaycloud_instance after create", map[string]interface{}{"id": d.Id()})
+
+		if err := setInstancePowerState(ctx, cfg.Client, cfg.ProjectID, d.Id(), powerStateShutoff, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("Error stopping vnpaycloud_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceInstanceRead(ctx, d, meta)
 }
 
@@ -211,13 +233,14 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("flavor_name", inst.FlavorName)
 	d.Set("volume_ids", inst.VolumeIDs)
 	d.Set("status", inst.Status)
-	d.Set("power_state", inst.PowerState)
+	d.Set("power_state", normalizePowerState(inst.PowerState))
 	d.Set("network_interface_ids", inst.NetworkInterfaceIDs)
 	d.Set("key_pair", inst.KeyPairID)
 	d.Set("security_groups", inst.SecurityGroupIDs)
 	d.Set("server_group_id", inst.ServerGroupID)
 	d.Set("zone_id", inst.ZoneID)
 	d.Set("created_at", inst.CreatedAt)
+	util.SetResourceTags(d, meta, inst.Tags)
 
 	return nil
 }
@@ -225,6 +248,61 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
+	// Start the instance before any other change, stop it only after them.
+	powerState := d.Get("power_state").(string)
+	if d.HasChange("power_state") && powerState == powerStateRunning {
+		tflog.Debug(ctx, "Starting vnpaycloud_instance", map[string]interface{}{"id": d.Id()})
+
+		if err := setInstancePowerState(ctx, cfg.Client, cfg.ProjectID, d.Id(), powerStateRunning, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error starting vnpaycloud_instance %s: %s", d.Id(), err)
+		}
+	}
+
+	// CustomizeDiff only lets an image change through without replacement
+	// when rebuild_on_image_change is set.
+	if d.HasChange("image") {
+		rebuildOpts := dto.RebuildInstanceRequest{Image: d.Get("image").(string)}
+
+		tflog.Debug(ctx, "vnpaycloud_instance rebuild options", map[string]interface{}{"rebuild_opts": rebuildOpts})
+
+		if _, err := cfg.Client.Post(ctx, client.ApiPath.InstanceRebuild(cfg.ProjectID, d.Id()), rebuildOpts, nil, nil); err != nil {
+			return diag.Errorf("Error rebuilding vnpaycloud_instance %s: %s", d.Id(), err)
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"rebuild", "rebuilding", "rebuild_spawning", "rebuild_block_device_mapping"},
+			Target:     []string{"active", "running"},
+			Refresh:    instanceStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+
+		if err := waitForInstanceState(ctx, cfg.Client, cfg.ProjectID, d.Id(), stateConf); err != nil {
+			return diag.Errorf("Error waiting for vnpaycloud_instance %s to finish rebuilding: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("user_data", "is_user_data_base64", "key_pair") {
+		metadataOpts := dto.UpdateInstanceMetadataRequest{}
+
+		if d.HasChanges("user_data", "is_user_data_base64") {
+			userData := d.Get("user_data").(string)
+			metadataOpts.UserData = &userData
+			metadataOpts.IsUserDataBase64 = d.Get("is_user_data_base64").(bool)
+		}
+
+		if d.HasChange("key_pair") {
+			metadataOpts.KeyPair = d.Get("key_pair").(string)
+		}
+
+		tflog.Debug(ctx, "vnpaycloud_instance metadata update options", map[string]interface{}{"key_pair": metadataOpts.KeyPair, "user_data_changed": metadataOpts.UserData != nil})
+
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.InstanceMetadata(cfg.ProjectID, d.Id()), metadataOpts, nil, nil); err != nil {
+			return diag.Errorf("Error updating metadata of vnpaycloud_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	// Update name and/or security_groups
 	if d.HasChanges("name", "security_groups") {
 		updateOpts := dto.UpdateInstanceRequest{}
@@ -250,6 +328,73 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		tagsOpts := dto.UpdateTagsRequest{Tags: util.GetTagsAll(d, meta)}
+
+		tflog.Debug(ctx, "vnpaycloud_instance update tags options", map[string]interface{}{"tags_opts": tagsOpts})
+
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.InstanceTags(cfg.ProjectID, d.Id()), tagsOpts, nil, nil); err != nil {
+			return diag.Errorf("Error updating tags for vnpaycloud_instance %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("root_disk_gb", "root_disk_type") {
+		rootVolumeID, err := findInstanceRootVolume(ctx, cfg.Client, cfg.ProjectID, d.Id())
+		if err != nil {
+			return diag.Errorf("Error finding root volume of vnpaycloud_instance %s: %s", d.Id(), err)
+		}
+
+		if d.HasChange("root_disk_gb") {
+			resizeOpts := dto.ResizeVolumeRequest{SizeGB: int64(d.Get("root_disk_gb").(int))}
+
+			tflog.Debug(ctx, "vnpaycloud_instance root disk resize options", map[string]interface{}{"volume_id": rootVolumeID, "resize_opts": resizeOpts})
+
+			if _, err := cfg.Client.Post(ctx, client.ApiPath.VolumeResize(cfg.ProjectID, rootVolumeID), resizeOpts, nil, nil); err != nil {
+				return diag.Errorf("Error resizing root volume %s of vnpaycloud_instance %s: %s", rootVolumeID, d.Id(), err)
+			}
+
+			stateConf := &retry.StateChangeConf{
+				Pending:    []string{"resizing", "extending"},
+				Target:     []string{"active", "available", "in-use"},
+				Refresh:    rootVolumeStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, rootVolumeID),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Delay:      5 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return diag.Errorf("Error waiting for root volume %s of vnpaycloud_instance %s to finish resizing: %s", rootVolumeID, d.Id(), err)
+			}
+		}
+
+		if d.HasChange("root_disk_type") {
+			// The root volume is attached, so the backend may need to migrate it.
+			retypeOpts := dto.RetypeVolumeRequest{
+				VolumeType:      d.Get("root_disk_type").(string),
+				MigrationPolicy: "on-demand",
+			}
+
+			tflog.Debug(ctx, "vnpaycloud_instance root disk retype options", map[string]interface{}{"volume_id": rootVolumeID, "retype_opts": retypeOpts})
+
+			if _, err := cfg.Client.Post(ctx, client.ApiPath.VolumeRetype(cfg.ProjectID, rootVolumeID), retypeOpts, nil, nil); err != nil {
+				return diag.Errorf("Error retyping root volume %s of vnpaycloud_instance %s: %s", rootVolumeID, d.Id(), err)
+			}
+
+			stateConf := &retry.StateChangeConf{
+				Pending:    []string{"retyping", "migrating"},
+				Target:     []string{"active", "available", "in-use"},
+				Refresh:    rootVolumeStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, rootVolumeID),
+				Timeout:    d.Timeout(schema.TimeoutUpdate),
+				Delay:      5 * time.Second,
+				MinTimeout: 3 * time.Second,
+			}
+
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+				return diag.Errorf("Error waiting for root volume %s of vnpaycloud_instance %s to finish retyping: %s", rootVolumeID, d.Id(), err)
+			}
+		}
+	}
+
 	// Resize (flavor change)
 	if d.HasChanges("flavor", "custom_vcpus", "custom_ram_mb") {
 		resizeOpts := dto.ResizeInstanceRequest{
@@ -275,15 +420,145 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			MinTimeout: 5 * time.Second,
 		}
 
-		_, err = stateConf.WaitForStateContext(ctx)
+		err = waitForInstanceState(ctx, cfg.Client, cfg.ProjectID, d.Id(), stateConf)
 		if err != nil {
 			return diag.Errorf("Error waiting for vnpaycloud_instance %s to finish resizing: %s", d.Id(), err)
 		}
 	}
 
+	if d.HasChange("server_group_id") {
+		migrateOpts := dto.MigrateInstanceRequest{ServerGroupID: d.Get("server_group_id").(string)}
+
+		tflog.Debug(ctx, "vnpaycloud_instance migrate options", map[string]interface{}{"migrate_opts": migrateOpts})
+
+		if _, err := cfg.Client.Post(ctx, client.ApiPath.InstanceMigrate(cfg.ProjectID, d.Id()), migrateOpts, nil, nil); err != nil {
+			return diag.Errorf("Error migrating vnpaycloud_instance %s to server group %q: %s", d.Id(), migrateOpts.ServerGroupID, err)
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"migrating", "resize", "resizing", "verify_resize"},
+			Target:     []string{"active", "running", "shutoff", "stopped"},
+			Refresh:    instanceStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+
+		if err := waitForInstanceState(ctx, cfg.Client, cfg.ProjectID, d.Id(), stateConf); err != nil {
+			return diag.Errorf("Error waiting for vnpaycloud_instance %s to finish migrating: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("power_state") && powerState == powerStateShutoff {
+		tflog.Debug(ctx, "Stopping vnpaycloud_instance", map[string]interface{}{"id": d.Id()})
+
+		if err := setInstancePowerState(ctx, cfg.Client, cfg.ProjectID, d.Id(), powerStateShutoff, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error stopping vnpaycloud_instance %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceInstanceRead(ctx, d, meta)
 }
 
+func resourceInstanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Changing the image replaces the instance unless it may be rebuilt in place.
+	if d.Id() != "" && d.HasChange("image") && !d.Get("rebuild_on_image_change").(bool) {
+		if err := d.ForceNew("image"); err != nil {
+			return err
+		}
+	}
+
+	// Changing user data replaces the instance only when asked to.
+	if d.Id() != "" && d.Get("user_data_replace_on_change").(bool) {
+		for _, k := range []string{"user_data", "is_user_data_base64"} {
+			if d.HasChange(k) {
+				if err := d.ForceNew(k); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if err := validateInstanceSizing(d); err != nil {
+		return err
+	}
+
+	cfg := meta.(*config.Config)
+
+	// Check the flavor and image against the catalogue only when they are
+	// about to be used, so unrelated plans don't pay for the lookups.
+	if d.NewValueKnown("flavor") && d.Get("flavor").(string) != "" && (d.Id() == "" || d.HasChange("flavor")) {
+		name := d.Get("flavor").(string)
+		flavor, err := findFlavorByName(ctx, cfg.Client, cfg.ZoneID, name)
+		if err != nil {
+			return fmt.Errorf("error looking up flavor %q: %s", name, err)
+		}
+		if flavor == nil {
+			return fmt.Errorf("flavor %q does not exist in zone %s", name, cfg.ZoneID)
+		}
+	}
+
+	// Check the minimum disk size of the image on create or when it changes.
+	// An existing instance may run an image that has since been retired from
+	// the catalogue, so on update a missing image skips the check.
+	if d.NewValueKnown("image") && d.NewValueKnown("root_disk_gb") && d.Get("image").(string) != "" && (d.Id() == "" || d.HasChange("image")) {
+		name := d.Get("image").(string)
+		image, err := findImageByNameOrID(ctx, cfg.Client, cfg.ProjectID, cfg.ZoneID, name)
+		if err != nil {
+			return fmt.Errorf("error looking up image %q: %s", name, err)
+		}
+		if image == nil {
+			if d.Id() == "" {
+				return fmt.Errorf("image %q does not exist in zone %s", name, cfg.ZoneID)
+			}
+		} else if rootDiskGB := d.Get("root_disk_gb").(int); rootDiskGB < int(image.MinDiskGB) {
+			return fmt.Errorf("root_disk_gb (%d) is smaller than the minimum disk size of image %q (%d GB)", rootDiskGB, name, image.MinDiskGB)
+		}
+	}
+
+	// The root disk can only grow in place.
+	if d.Id() != "" && d.HasChange("root_disk_gb") {
+		o, n := d.GetChange("root_disk_gb")
+		if n.(int) < o.(int) {
+			return fmt.Errorf("root_disk_gb cannot be shrunk from %d GB to %d GB", o.(int), n.(int))
+		}
+	}
+
+	return util.SetTagsDiff(ctx, d, meta)
+}
+
+// validateInstanceSizing enforces that the instance is sized either by a named
+// flavor or by custom vCPU/RAM values, but not both.
+func validateInstanceSizing(d *schema.ResourceDiff) error {
+	for _, k := range []string{"flavor", "is_custom_flavor", "custom_vcpus", "custom_ram_mb"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+
+	flavor := d.Get("flavor").(string)
+	vcpus := d.Get("custom_vcpus").(int)
+	ramMB := d.Get("custom_ram_mb").(int)
+
+	if d.Get("is_custom_flavor").(bool) {
+		if flavor != "" {
+			return fmt.Errorf("flavor cannot be set when is_custom_flavor = true")
+		}
+		if vcpus <= 0 || ramMB <= 0 {
+			return fmt.Errorf("custom_vcpus and custom_ram_mb are required when is_custom_flavor = true")
+		}
+		return nil
+	}
+
+	if flavor == "" {
+		return fmt.Errorf("one of flavor or is_custom_flavor = true (with custom_vcpus and custom_ram_mb) is required")
+	}
+	if vcpus != 0 || ramMB != 0 {
+		return fmt.Errorf("custom_vcpus and custom_ram_mb can only be set when is_custom_flavor = true")
+	}
+	return nil
+}
+
 func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
@@ -2,13 +2,20 @@ package instance
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // testInstance returns a fully populated dto.Instance for use in tests.
@@ -32,6 +39,55 @@ func testInstance() dto.Instance {
 	}
 }
 
+// testInstanceResourceData returns ResourceData for instance inst-001 with
+// the given prior state and planned configuration, so HasChange only reports
+// attributes that differ between the two.
+func testInstanceResourceData(t *testing.T, res *schema.Resource, cfg *config.Config, state map[string]string, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	is := &terraform.InstanceState{ID: "inst-001", Attributes: state}
+	diff, err := res.Diff(context.Background(), is, terraform.NewResourceConfigRaw(raw), cfg)
+	if err != nil {
+		t.Fatalf("unexpected diff error: %v", err)
+	}
+
+	d, err := schema.InternalMap(res.Schema).Data(is, diff)
+	if err != nil {
+		t.Fatalf("unexpected error building resource data: %v", err)
+	}
+	return d
+}
+
+// testCatalogueRoutes serves the flavor and image catalogue and the project's
+// private images the instance CustomizeDiff checks against.
+func testCatalogueRoutes(t *testing.T) []testhelpers.Route {
+	return []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/flavors",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListFlavorsResponse{Flavors: []dto.Flavor{
+				{ID: "flv-001", Name: "v1.small", VCPUs: 2, RAMMB: 4096, Zone: testhelpers.TestZoneID},
+			}}),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/images",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListImagesResponse{Images: []dto.Image{
+				{ID: "img-001", Name: "ubuntu-22.04", MinDiskGB: 10, Zone: testhelpers.TestZoneID},
+				{ID: "img-002", Name: "ubuntu-24.04", MinDiskGB: 20, Zone: testhelpers.TestZoneID},
+				{ID: "img-003", Name: "windows-2022", MinDiskGB: 50, Zone: testhelpers.TestZoneID},
+			}}),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/images",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListImagesResponse{Images: []dto.Image{
+				{ID: "img-101", Name: "golden-web", MinDiskGB: 40, Visibility: "private"},
+			}}),
+		},
+	}
+}
+
 func TestResourceInstanceCreate(t *testing.T) {
 	inst := testInstance()
 
@@ -247,3 +303,699 @@ func TestResourceInstanceDelete(t *testing.T) {
 		t.Error("expected DELETE to have been called")
 	}
 }
+
+func TestResourceInstanceUpdate_PowerStateShutoff(t *testing.T) {
+	inst := testInstance()
+	inst.Status = "shutoff"
+	inst.PowerState = "Shutdown"
+
+	stopCalled := false
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001/stop",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				stopCalled = true
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001/start",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				t.Error("expected start not to be called")
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceInstance()
+	d := testInstanceResourceData(t, res, cfg, map[string]string{
+		"root_disk_gb":   "20",
+		"root_disk_type": "SSD",
+		"flavor":         "v1.small",
+		"power_state":    "running",
+	}, map[string]interface{}{
+		"root_disk_gb":   20,
+		"root_disk_type": "SSD",
+		"flavor":         "v1.small",
+		"power_state":    "shutoff",
+	})
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !stopCalled {
+		t.Error("expected stop to have been called")
+	}
+	if v := d.Get("power_state").(string); v != "shutoff" {
+		t.Errorf("expected power_state shutoff, got %s", v)
+	}
+}
+
+func TestNormalizePowerState(t *testing.T) {
+	tests := map[string]string{
+		"Running":  "running",
+		"active":   "running",
+		"Shutdown": "shutoff",
+		"SHUTOFF":  "shutoff",
+		"stopped":  "shutoff",
+		"Paused":   "paused",
+	}
+
+	for in, want := range tests {
+		if got := normalizePowerState(in); got != want {
+			t.Errorf("normalizePowerState(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestResourceInstanceCustomizeDiff_ImageChange(t *testing.T) {
+	tests := []struct {
+		name            string
+		rebuild         bool
+		wantRequiresNew bool
+	}{
+		{name: "replace by default", rebuild: false, wantRequiresNew: true},
+		{name: "rebuild in place", rebuild: true, wantRequiresNew: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ResourceInstance()
+			state := &terraform.InstanceState{
+				ID: "inst-001",
+				Attributes: map[string]string{
+					"name":           "test-instance",
+					"image":          "ubuntu-20.04",
+					"root_disk_gb":   "20",
+					"root_disk_type": "SSD",
+					"flavor":         "v1.small",
+				},
+			}
+			raw := map[string]interface{}{
+				"name":                    "test-instance",
+				"image":                   "ubuntu-22.04",
+				"root_disk_gb":            20,
+				"root_disk_type":          "SSD",
+				"flavor":                  "v1.small",
+				"rebuild_on_image_change": tt.rebuild,
+			}
+
+			srv := testhelpers.NewMockServer(t, testCatalogueRoutes(t))
+			cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+			diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), cfg)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff == nil {
+				t.Fatal("expected a diff, got nil")
+			}
+			if got := diff.RequiresNew(); got != tt.wantRequiresNew {
+				t.Errorf("expected RequiresNew %v, got %v", tt.wantRequiresNew, got)
+			}
+		})
+	}
+}
+
+func TestResourceInstanceUpdate_Rebuild(t *testing.T) {
+	inst := testInstance()
+	inst.ImageName = "ubuntu-24.04"
+	inst.ImageID = "img-002"
+
+	var rebuildBody dto.RebuildInstanceRequest
+	deleteCalled := false
+
+	srv := testhelpers.NewMockServer(t, append(testCatalogueRoutes(t), []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001/rebuild",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&rebuildBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+		{
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst})(w, r)
+				case http.MethodDelete:
+					deleteCalled = true
+					w.WriteHeader(http.StatusOK)
+				default:
+					w.WriteHeader(http.StatusOK)
+				}
+			},
+		},
+	}...))
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceInstance()
+	d := testInstanceResourceData(t, res, cfg, map[string]string{
+		"image":                   "ubuntu-22.04",
+		"rebuild_on_image_change": "true",
+		"root_disk_gb":            "20",
+		"root_disk_type":          "SSD",
+		"flavor":                  "v1.small",
+	}, map[string]interface{}{
+		"image":                   "ubuntu-24.04",
+		"rebuild_on_image_change": true,
+		"root_disk_gb":            20,
+		"root_disk_type":          "SSD",
+		"flavor":                  "v1.small",
+	})
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if rebuildBody.Image != "ubuntu-24.04" {
+		t.Errorf("expected rebuild with image ubuntu-24.04, got %q", rebuildBody.Image)
+	}
+	if deleteCalled {
+		t.Error("expected instance to be rebuilt in place, but DELETE was called")
+	}
+	if d.Id() != "inst-001" {
+		t.Errorf("expected ID to remain inst-001, got %s", d.Id())
+	}
+	if v := d.Get("image_id").(string); v != "img-002" {
+		t.Errorf("expected image_id img-002, got %s", v)
+	}
+	if v := d.Get("network_interface_ids").([]interface{}); len(v) != 1 || v[0] != "ni-001" {
+		t.Errorf("expected network_interface_ids to be preserved, got %v", v)
+	}
+}
+
+func TestResourceInstanceCustomizeDiff_RootDiskShrink(t *testing.T) {
+	res := ResourceInstance()
+	state := &terraform.InstanceState{
+		ID: "inst-001",
+		Attributes: map[string]string{
+			"name":           "test-instance",
+			"root_disk_gb":   "40",
+			"root_disk_type": "SSD",
+			"flavor":         "v1.small",
+		},
+	}
+	raw := map[string]interface{}{
+		"name":           "test-instance",
+		"root_disk_gb":   20,
+		"root_disk_type": "SSD",
+		"flavor":         "v1.small",
+	}
+
+	srv := testhelpers.NewMockServer(t, testCatalogueRoutes(t))
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	_, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), cfg)
+	if err == nil {
+		t.Fatal("expected error when shrinking root_disk_gb, got nil")
+	}
+	if !strings.Contains(err.Error(), "cannot be shrunk") {
+		t.Errorf("expected shrink error, got: %v", err)
+	}
+}
+
+func TestResourceInstanceCustomizeDiff_RootDiskGrowRetiredImage(t *testing.T) {
+	res := ResourceInstance()
+	state := &terraform.InstanceState{
+		ID: "inst-001",
+		Attributes: map[string]string{
+			"name":           "test-instance",
+			"image":          "centos-7",
+			"root_disk_gb":   "40",
+			"root_disk_type": "SSD",
+			"flavor":         "v1.small",
+		},
+	}
+	raw := map[string]interface{}{
+		"name":           "test-instance",
+		"image":          "centos-7",
+		"root_disk_gb":   60,
+		"root_disk_type": "SSD",
+		"flavor":         "v1.small",
+	}
+
+	// centos-7 is no longer in the catalogue.
+	srv := testhelpers.NewMockServer(t, testCatalogueRoutes(t))
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if diff == nil || diff.Attributes["root_disk_gb"] == nil || diff.Attributes["root_disk_gb"].New != "60" {
+		t.Errorf("expected root_disk_gb to grow to 60, got %+v", diff)
+	}
+}
+
+func TestResourceInstanceUpdate_RootDiskResizeAndRetype(t *testing.T) {
+	inst := testInstance()
+	inst.VolumeIDs = []string{"vol-data", "vol-root"}
+
+	var mu sync.Mutex
+	var calls []string
+	var resizeBody dto.ResizeVolumeRequest
+	var retypeBody dto.RetypeVolumeRequest
+
+	volume := func(id string, bootable bool) http.HandlerFunc {
+		return testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: dto.Volume{
+			ID:         id,
+			IsBootable: bootable,
+			Status:     "in-use",
+		}})
+	}
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst}),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-data",
+			Handler: volume("vol-data", false),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-root",
+			Handler: volume("vol-root", true),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-root/resize",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				calls = append(calls, "resize")
+				mu.Unlock()
+				if err := json.NewDecoder(r.Body).Decode(&resizeBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-root/retype",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				calls = append(calls, "retype")
+				mu.Unlock()
+				if err := json.NewDecoder(r.Body).Decode(&retypeBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceInstance()
+	d := testInstanceResourceData(t, res, cfg, map[string]string{
+		"root_disk_gb":   "20",
+		"root_disk_type": "SSD",
+		"flavor":         "v1.small",
+	}, map[string]interface{}{
+		"root_disk_gb":   40,
+		"root_disk_type": "NVMe",
+		"flavor":         "v1.small",
+	})
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if strings.Join(calls, ",") != "resize,retype" {
+		t.Errorf("expected resize then retype of the root volume, got %v", calls)
+	}
+	if resizeBody.SizeGB != 40 {
+		t.Errorf("expected resize to 40 GB, got %d", resizeBody.SizeGB)
+	}
+	if retypeBody.VolumeType != "NVMe" {
+		t.Errorf("expected retype to NVMe, got %s", retypeBody.VolumeType)
+	}
+	if retypeBody.MigrationPolicy != "on-demand" {
+		t.Errorf("expected migration policy on-demand, got %q", retypeBody.MigrationPolicy)
+	}
+	if d.Id() != "inst-001" {
+		t.Errorf("expected ID to remain inst-001, got %s", d.Id())
+	}
+}
+
+// TestResourceInstanceUpdate_RootDiskNoBootableVolume verifies that a root
+// disk change fails instead of resizing a volume not flagged bootable.
+func TestResourceInstanceUpdate_RootDiskNoBootableVolume(t *testing.T) {
+	inst := testInstance()
+	inst.VolumeIDs = []string{"vol-data"}
+
+	resized := false
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst}),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-data",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: dto.Volume{ID: "vol-data", Status: "in-use"}}),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-data/resize",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				resized = true
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceInstance()
+	d := testInstanceResourceData(t, res, cfg, map[string]string{
+		"root_disk_gb":   "20",
+		"root_disk_type": "SSD",
+		"flavor":         "v1.small",
+	}, map[string]interface{}{
+		"root_disk_gb":   40,
+		"root_disk_type": "SSD",
+		"flavor":         "v1.small",
+	})
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if !diags.HasError() {
+		t.Fatal("expected error when no volume is bootable, got nil")
+	}
+	if !strings.Contains(diags[0].Summary, "no bootable root volume found for instance inst-001") {
+		t.Errorf("unexpected error: %v", diags)
+	}
+	if resized {
+		t.Error("expected the data volume not to be resized")
+	}
+}
+
+func TestResourceInstanceCustomizeDiff_Validation(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "named flavor",
+			raw:  map[string]interface{}{"image": "ubuntu-22.04", "flavor": "v1.small", "root_disk_gb": 20},
+		},
+		{
+			name: "custom sizing",
+			raw:  map[string]interface{}{"image": "ubuntu-22.04", "is_custom_flavor": true, "custom_vcpus": 4, "custom_ram_mb": 8192, "root_disk_gb": 20},
+		},
+		{
+			name:    "flavor and custom sizing",
+			raw:     map[string]interface{}{"image": "ubuntu-22.04", "flavor": "v1.small", "is_custom_flavor": true, "custom_vcpus": 4, "custom_ram_mb": 8192, "root_disk_gb": 20},
+			wantErr: "flavor cannot be set when is_custom_flavor = true",
+		},
+		{
+			name:    "no sizing",
+			raw:     map[string]interface{}{"image": "ubuntu-22.04", "root_disk_gb": 20},
+			wantErr: "one of flavor or is_custom_flavor = true",
+		},
+		{
+			name:    "custom sizing without vcpus",
+			raw:     map[string]interface{}{"image": "ubuntu-22.04", "is_custom_flavor": true, "custom_ram_mb": 8192, "root_disk_gb": 20},
+			wantErr: "custom_vcpus and custom_ram_mb are required",
+		},
+		{
+			name:    "unknown flavor",
+			raw:     map[string]interface{}{"image": "ubuntu-22.04", "flavor": "v9.huge", "root_disk_gb": 20},
+			wantErr: `flavor "v9.huge" does not exist in zone test-zone-id`,
+		},
+		{
+			name:    "unknown image",
+			raw:     map[string]interface{}{"image": "centos-6", "flavor": "v1.small", "root_disk_gb": 20},
+			wantErr: `image "centos-6" does not exist in zone test-zone-id`,
+		},
+		{
+			name: "private image",
+			raw:  map[string]interface{}{"image": "golden-web", "flavor": "v1.small", "root_disk_gb": 40},
+		},
+		{
+			name:    "private image below minimum",
+			raw:     map[string]interface{}{"image": "golden-web", "flavor": "v1.small", "root_disk_gb": 30},
+			wantErr: `root_disk_gb (30) is smaller than the minimum disk size of image "golden-web" (40 GB)`,
+		},
+		{
+			name:    "root disk below image minimum",
+			raw:     map[string]interface{}{"image": "windows-2022", "flavor": "v1.small", "root_disk_gb": 40},
+			wantErr: `root_disk_gb (40) is smaller than the minimum disk size of image "windows-2022" (50 GB)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := testhelpers.NewMockServer(t, testCatalogueRoutes(t))
+			cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+			raw := map[string]interface{}{"name": "test-instance", "root_disk_type": "SSD"}
+			for k, v := range tt.raw {
+				raw[k] = v
+			}
+
+			res := ResourceInstance()
+			_, err := res.Diff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(raw), cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestWaitForInstanceState_TimeoutIncludesConsoleTail(t *testing.T) {
+	inst := testInstance()
+	inst.Status = "build"
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst}),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001/console-output",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceConsoleOutputResponse{
+				Output: "cloud-init: waiting for network\nKernel panic - not syncing\n",
+			}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"build"},
+		Target:     []string{"active"},
+		Refresh:    instanceStateRefreshFunc(context.Background(), cfg.Client, cfg.ProjectID, "inst-001"),
+		Timeout:    200 * time.Millisecond,
+		MinTimeout: 10 * time.Millisecond,
+	}
+
+	err := waitForInstanceState(context.Background(), cfg.Client, cfg.ProjectID, "inst-001", stateConf)
+	if err == nil {
+		t.Fatal("expected timeout error, got nil")
+	}
+	if !strings.Contains(err.Error(), "timeout while waiting") {
+		t.Errorf("expected the waiter timeout error, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "Kernel panic - not syncing") {
+		t.Errorf("expected console tail in error, got: %v", err)
+	}
+}
+
+func TestResourceInstanceCustomizeDiff_UserDataChange(t *testing.T) {
+	tests := []struct {
+		name            string
+		replaceOnChange bool
+		wantRequiresNew bool
+	}{
+		{name: "update in place by default", replaceOnChange: false, wantRequiresNew: false},
+		{name: "replace when requested", replaceOnChange: true, wantRequiresNew: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ResourceInstance()
+			state := &terraform.InstanceState{
+				ID: "inst-001",
+				Attributes: map[string]string{
+					"name":           "test-instance",
+					"root_disk_gb":   "20",
+					"root_disk_type": "SSD",
+					"flavor":         "v1.small",
+					"user_data":      "#!/bin/sh\necho v1\n",
+				},
+			}
+			raw := map[string]interface{}{
+				"name":                        "test-instance",
+				"root_disk_gb":                20,
+				"root_disk_type":              "SSD",
+				"flavor":                      "v1.small",
+				"user_data":                   "#!/bin/sh\necho v2\n",
+				"user_data_replace_on_change": tt.replaceOnChange,
+			}
+
+			srv := testhelpers.NewMockServer(t, testCatalogueRoutes(t))
+			cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+			diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), cfg)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff == nil {
+				t.Fatal("expected a diff, got nil")
+			}
+			if got := diff.RequiresNew(); got != tt.wantRequiresNew {
+				t.Errorf("expected RequiresNew %v, got %v", tt.wantRequiresNew, got)
+			}
+		})
+	}
+}
+
+func TestResourceInstanceUpdate_MetadataInPlace(t *testing.T) {
+	inst := testInstance()
+	inst.KeyPairID = "kp-002"
+
+	var metadataBody map[string]interface{}
+	deleteCalled := false
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "PUT",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001/metadata",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&metadataBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst})(w, r)
+				case http.MethodDelete:
+					deleteCalled = true
+					w.WriteHeader(http.StatusOK)
+				default:
+					w.WriteHeader(http.StatusOK)
+				}
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceInstance()
+	d := testInstanceResourceData(t, res, cfg, map[string]string{
+		"root_disk_gb":   "20",
+		"root_disk_type": "SSD",
+		"flavor":         "v1.small",
+		"key_pair":       "kp-001",
+		"user_data":      "#!/bin/sh\necho v1\n",
+	}, map[string]interface{}{
+		"root_disk_gb":   20,
+		"root_disk_type": "SSD",
+		"flavor":         "v1.small",
+		"key_pair":       "kp-002",
+		"user_data":      "#!/bin/sh\necho v2\n",
+	})
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if deleteCalled {
+		t.Error("expected instance not to be deleted")
+	}
+	if v := metadataBody["keyPair"]; v != "kp-002" {
+		t.Errorf("expected keyPair kp-002 in metadata request, got %v", v)
+	}
+	if v := metadataBody["userData"]; v != "#!/bin/sh\necho v2\n" {
+		t.Errorf("expected new userData in metadata request, got %v", v)
+	}
+	if v := d.Get("key_pair").(string); v != "kp-002" {
+		t.Errorf("expected key_pair kp-002, got %s", v)
+	}
+}
+
+func TestResourceInstanceUpdate_ServerGroupMigrate(t *testing.T) {
+	inst := testInstance()
+	inst.ServerGroupID = "sgrp-002"
+
+	var migrateBody dto.MigrateInstanceRequest
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001/migrate",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&migrateBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/inst-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceInstance()
+	d := testInstanceResourceData(t, res, cfg, map[string]string{
+		"root_disk_gb":    "20",
+		"root_disk_type":  "SSD",
+		"flavor":          "v1.small",
+		"server_group_id": "sgrp-001",
+	}, map[string]interface{}{
+		"root_disk_gb":    20,
+		"root_disk_type":  "SSD",
+		"flavor":          "v1.small",
+		"server_group_id": "sgrp-002",
+	})
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if migrateBody.ServerGroupID != "sgrp-002" {
+		t.Errorf("expected migration to sgrp-002, got %s", migrateBody.ServerGroupID)
+	}
+	if v := d.Get("server_group_id").(string); v != "sgrp-002" {
+		t.Errorf("expected server_group_id sgrp-002, got %s", v)
+	}
+}
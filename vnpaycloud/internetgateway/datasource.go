@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -62,15 +63,14 @@ func dataSourceInternetGatewayRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// List and filter client-side
-	listResp := &dto.ListInternetGatewaysResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.InternetGateways(cfg.ProjectID), listResp, nil)
+	internetGateways, err := client.ListAll(ctx, cfg.Client, client.ApiPath.InternetGateways(cfg.ProjectID), func(r *dto.ListInternetGatewaysResponse) []dto.InternetGateway { return r.InternetGateways })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_internet_gateway: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, igw := range listResp.InternetGateways {
+	for _, igw := range internetGateways {
 		if nameOk && igw.Name != nameFilter.(string) {
 			continue
 		}
@@ -95,6 +95,8 @@ func DataSourceInternetGateways() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceInternetGatewaysRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"internet_gateways": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -117,14 +119,18 @@ func DataSourceInternetGateways() *schema.Resource {
 func dataSourceInternetGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListInternetGatewaysResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.InternetGateways(cfg.ProjectID), listResp, nil)
+	allInternetGateways, err := client.ListAll(ctx, cfg.Client, client.ApiPath.InternetGateways(cfg.ProjectID), func(r *dto.ListInternetGatewaysResponse) []dto.InternetGateway { return r.InternetGateways })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_internet_gateways: %s", err)
 	}
 
+	allInternetGateways, err = util.ApplyDataSourceFilters(d, allInternetGateways, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_internet_gateways: %s", err)
+	}
+
 	var internetGateways []map[string]interface{}
-	for _, igw := range listResp.InternetGateways {
+	for _, igw := range allInternetGateways {
 		internetGateways = append(internetGateways, map[string]interface{}{
 			"id":          igw.ID,
 			"name":        igw.Name,
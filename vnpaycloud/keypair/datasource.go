@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -62,6 +63,8 @@ func DataSourceKeyPairs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceKeyPairsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"key_pairs": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -81,14 +84,18 @@ func DataSourceKeyPairs() *schema.Resource {
 func dataSourceKeyPairsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListKeyPairsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.KeyPairs(cfg.ProjectID), listResp, nil)
+	allKeyPairs, err := client.ListAll(ctx, cfg.Client, client.ApiPath.KeyPairs(cfg.ProjectID), func(r *dto.ListKeyPairsResponse) []dto.KeyPair { return r.KeyPairs })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_keypairs: %s", err)
 	}
 
+	allKeyPairs, err = util.ApplyDataSourceFilters(d, allKeyPairs, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_keypairs: %s", err)
+	}
+
 	var keyPairs []map[string]interface{}
-	for _, kp := range listResp.KeyPairs {
+	for _, kp := range allKeyPairs {
 		keyPairs = append(keyPairs, map[string]interface{}{
 			"name":        kp.Name,
 			"public_key":  kp.PublicKey,
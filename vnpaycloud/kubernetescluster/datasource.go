@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -93,15 +94,14 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 		return setClusterData(d, &resp.Cluster)
 	}
 
-	listResp := &dto.ListK8sClustersResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Clusters(cfg.ProjectID), listResp, nil)
+	clusters, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Clusters(cfg.ProjectID), func(r *dto.ListK8sClustersResponse) []dto.K8sCluster { return r.Clusters })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_kubernetes_cluster: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, cluster := range listResp.Clusters {
+	for _, cluster := range clusters {
 		if nameOk && cluster.Name != nameFilter.(string) {
 			continue
 		}
@@ -134,6 +134,8 @@ func DataSourceKubernetesClusters() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceClustersRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"clusters": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -157,14 +159,18 @@ func DataSourceKubernetesClusters() *schema.Resource {
 func dataSourceClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListK8sClustersResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Clusters(cfg.ProjectID), listResp, nil)
+	allClusters, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Clusters(cfg.ProjectID), func(r *dto.ListK8sClustersResponse) []dto.K8sCluster { return r.Clusters })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_kubernetes_clusters: %s", err)
 	}
 
+	allClusters, err = util.ApplyDataSourceFilters(d, allClusters, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_kubernetes_clusters: %s", err)
+	}
+
 	var clusters []map[string]interface{}
-	for _, c := range listResp.Clusters {
+	for _, c := range allClusters {
 		clusters = append(clusters, map[string]interface{}{
 			"id":           c.ID,
 			"name":         c.Name,
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -81,6 +82,8 @@ func DataSourceL7Policies() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceL7PoliciesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"l7policies": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -105,14 +108,18 @@ func DataSourceL7Policies() *schema.Resource {
 func dataSourceL7PoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListL7PoliciesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.L7Policies(cfg.ProjectID), resp, nil)
+	l7Policies, err := client.ListAll(ctx, cfg.Client, client.ApiPath.L7Policies(cfg.ProjectID), func(r *dto.ListL7PoliciesResponse) []dto.L7Policy { return r.L7Policies })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_lb_l7policies: %s", err)
 	}
 
+	l7Policies, err = util.ApplyDataSourceFilters(d, l7Policies, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_lb_l7policies: %s", err)
+	}
+
 	var policies []map[string]interface{}
-	for _, p := range resp.L7Policies {
+	for _, p := range l7Policies {
 		policies = append(policies, map[string]interface{}{
 			"id":               p.ID,
 			"name":             p.Name,
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -77,6 +78,7 @@ func DataSourceL7Rules() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceL7RulesRead,
 		Schema: map[string]*schema.Schema{
+			"filter": util.DataSourceFiltersSchema(),
 			"l7policy_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -105,14 +107,18 @@ func dataSourceL7RulesRead(ctx context.Context, d *schema.ResourceData, meta int
 	cfg := meta.(*config.Config)
 	l7policyID := d.Get("l7policy_id").(string)
 
-	resp := &dto.ListL7RulesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.L7Rules(cfg.ProjectID, l7policyID), resp, nil)
+	l7Rules, err := client.ListAll(ctx, cfg.Client, client.ApiPath.L7Rules(cfg.ProjectID, l7policyID), func(r *dto.ListL7RulesResponse) []dto.L7Rule { return r.L7Rules })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_lb_l7rules: %s", err)
 	}
 
+	l7Rules, err = util.ApplyDataSourceFilters(d, l7Rules, "")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_lb_l7rules: %s", err)
+	}
+
 	var rules []map[string]interface{}
-	for _, r := range resp.L7Rules {
+	for _, r := range l7Rules {
 		rules = append(rules, map[string]interface{}{
 			"id":           r.ID,
 			"l7policy_id":  r.L7PolicyID,
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,6 +15,8 @@ func DataSourceLBFlavors() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceLBFlavorsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"flavors": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -33,14 +36,18 @@ func DataSourceLBFlavors() *schema.Resource {
 func dataSourceLBFlavorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListLoadBalancerFlavorsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.LBFlavors(cfg.ProjectID), resp, nil)
+	allFlavors, err := client.ListAll(ctx, cfg.Client, client.ApiPath.LBFlavors(cfg.ProjectID), func(r *dto.ListLoadBalancerFlavorsResponse) []dto.LoadBalancerFlavor { return r.Flavors })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_lb_flavors: %s", err)
 	}
 
-	flavors := make([]map[string]interface{}, 0, len(resp.Flavors))
-	for _, f := range resp.Flavors {
+	allFlavors, err = util.ApplyDataSourceFilters(d, allFlavors, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_lb_flavors: %s", err)
+	}
+
+	flavors := make([]map[string]interface{}, 0, len(allFlavors))
+	for _, f := range allFlavors {
 		flavors = append(flavors, map[string]interface{}{
 			"id":          f.ID,
 			"name":        f.Name,
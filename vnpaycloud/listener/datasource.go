@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -130,6 +131,8 @@ func DataSourceListeners() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceListenersRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"listeners": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -163,14 +166,18 @@ func DataSourceListeners() *schema.Resource {
 func dataSourceListenersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListListenersResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Listeners(cfg.ProjectID), resp, nil)
+	allListeners, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Listeners(cfg.ProjectID), func(r *dto.ListListenersResponse) []dto.Listener { return r.Listeners })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_lb_listeners: %s", err)
 	}
 
+	allListeners, err = util.ApplyDataSourceFilters(d, allListeners, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_lb_listeners: %s", err)
+	}
+
 	var listeners []map[string]interface{}
-	for _, l := range resp.Listeners {
+	for _, l := range allListeners {
 		listeners = append(listeners, map[string]interface{}{
 			"id":                       l.ID,
 			"name":                     l.Name,
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -82,6 +83,8 @@ func DataSourceLoadBalancers() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceLoadBalancersRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"load_balancers": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -133,14 +136,18 @@ func DataSourceLoadBalancers() *schema.Resource {
 func dataSourceLoadBalancersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	lbsResp := &dto.ListLoadBalancersResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.LoadBalancers(cfg.ProjectID), lbsResp, nil)
+	loadBalancers, err := client.ListAll(ctx, cfg.Client, client.ApiPath.LoadBalancers(cfg.ProjectID), func(r *dto.ListLoadBalancersResponse) []dto.LoadBalancer { return r.LoadBalancers })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_lb_loadbalancers: %s", err)
 	}
 
+	loadBalancers, err = util.ApplyDataSourceFilters(d, loadBalancers, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_lb_loadbalancers: %s", err)
+	}
+
 	var lbs []map[string]interface{}
-	for _, lb := range lbsResp.LoadBalancers {
+	for _, lb := range loadBalancers {
 		lbs = append(lbs, map[string]interface{}{
 			"id":             lb.ID,
 			"name":           lb.Name,
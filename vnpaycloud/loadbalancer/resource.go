@@ -32,6 +32,7 @@ func ResourceLoadBalancer() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: util.SetTagsDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -82,6 +83,8 @@ func ResourceLoadBalancer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     util.TagsSchema(),
+			"tags_all": util.TagsAllSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -98,6 +101,7 @@ func resourceLoadBalancerCreate(ctx context.Context, d *schema.ResourceData, met
 		Description: d.Get("description").(string),
 		SubnetID:    d.Get("subnet_id").(string),
 		Flavor:      d.Get("flavor").(string),
+		Tags:        util.GetTagsAll(d, meta),
 	}
 
 	if v, ok := d.GetOk("floating_ip_id"); ok {
@@ -155,6 +159,7 @@ func resourceLoadBalancerRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("vip_subnet_id", lbResp.LoadBalancer.VipSubnetID)
 	d.Set("status", lbResp.LoadBalancer.Status)
 	d.Set("created_at", lbResp.LoadBalancer.CreatedAt)
+	util.SetResourceTags(d, meta, lbResp.LoadBalancer.Tags)
 
 	return nil
 }
@@ -255,6 +260,16 @@ func resourceLoadBalancerUpdate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		tagsOpts := dto.UpdateTagsRequest{Tags: util.GetTagsAll(d, meta)}
+
+		tflog.Debug(ctx, "vnpaycloud_lb_loadbalancer update tags options", map[string]interface{}{"tags_opts": tagsOpts})
+
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.LoadBalancerTags(cfg.ProjectID, d.Id()), tagsOpts, nil, nil); err != nil {
+			return diag.Errorf("Error updating tags for vnpaycloud_lb_loadbalancer %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceLoadBalancerRead(ctx, d, meta)
 }
 
@@ -74,15 +74,14 @@ func dataSourceNetworkACLRead(ctx context.Context, d *schema.ResourceData, meta
 		path += "?vpc_id=" + url.QueryEscape(vpcID.(string))
 	}
 
-	listResp := &dto.ListNetworkACLsResponse{}
-	_, err := cfg.Client.Get(ctx, path, listResp, nil)
+	networkACLs, err := client.ListAll(ctx, cfg.Client, path, func(r *dto.ListNetworkACLsResponse) []dto.NetworkACL { return r.NetworkACLs })
 	if err != nil {
 		return diag.Errorf("Unable to query vnpaycloud_network_acl: %s", err)
 	}
 
 	name := d.Get("name").(string)
 	var matched []dto.NetworkACL
-	for _, acl := range listResp.NetworkACLs {
+	for _, acl := range networkACLs {
 		if name != "" && acl.Name != name {
 			continue
 		}
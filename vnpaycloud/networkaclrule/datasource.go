@@ -95,15 +95,14 @@ func dataSourceNetworkACLRuleRead(ctx context.Context, d *schema.ResourceData, m
 
 	path := client.ApiPath.NetworkACLRules(cfg.ProjectID) + "?nacl_id=" + url.QueryEscape(naclID.(string))
 
-	listResp := &dto.ListNetworkACLRulesResponse{}
-	_, err := cfg.Client.Get(ctx, path, listResp, nil)
+	networkACLRules, err := client.ListAll(ctx, cfg.Client, path, func(r *dto.ListNetworkACLRulesResponse) []dto.NetworkACLRule { return r.NetworkACLRules })
 	if err != nil {
 		return diag.Errorf("Unable to query vnpaycloud_network_acl_rule: %s", err)
 	}
 
 	name := d.Get("name").(string)
 	var matched []dto.NetworkACLRule
-	for _, rule := range listResp.NetworkACLRules {
+	for _, rule := range networkACLRules {
 		if name != "" && rule.Name != name {
 			continue
 		}
@@ -110,15 +110,14 @@ func dataSourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Otherwise, list and filter by name
-	listResp := &dto.ListNetworkInterfacesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.NetworkInterfaces(cfg.ProjectID), listResp, nil)
+	networkInterfaces, err := client.ListAll(ctx, cfg.Client, client.ApiPath.NetworkInterfaces(cfg.ProjectID), func(r *dto.ListNetworkInterfacesResponse) []dto.NetworkInterface { return r.NetworkInterfaces })
 	if err != nil {
 		return diag.Errorf("Unable to query vnpaycloud_network_interface: %s", err)
 	}
 
 	name := d.Get("name").(string)
 	var matched []dto.NetworkInterface
-	for _, ni := range listResp.NetworkInterfaces {
+	for _, ni := range networkInterfaces {
 		if name != "" && ni.Name != name {
 			continue
 		}
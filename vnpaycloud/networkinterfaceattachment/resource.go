@@ -2,7 +2,9 @@ package networkinterfaceattachment
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
@@ -19,6 +21,9 @@ func ResourceNetworkInterfaceAttachment() *schema.Resource {
 		CreateContext: resourceNetworkInterfaceAttachmentCreate,
 		ReadContext:   resourceNetworkInterfaceAttachmentRead,
 		DeleteContext: resourceNetworkInterfaceAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNetworkInterfaceAttachmentImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -142,6 +147,30 @@ func resourceNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.Res
 	return nil
 }
 
+// resourceNetworkInterfaceAttachmentImport accepts <nic_id>/<instance_id>.
+func resourceNetworkInterfaceAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("import id must be <nic_id>/<instance_id>, got: %s", d.Id())
+	}
+	nicID, serverID := parts[0], parts[1]
+
+	cfg := meta.(*config.Config)
+	attached, err := serverHasNIC(ctx, cfg.Client, cfg.ProjectID, serverID, nicID)
+	if err != nil {
+		return nil, fmt.Errorf("vnpaycloud_network_interface_attachment: instance %q not found: %w", serverID, err)
+	}
+	if !attached {
+		return nil, fmt.Errorf("vnpaycloud_network_interface_attachment: network interface %s is not attached to instance %s", nicID, serverID)
+	}
+
+	d.SetId(nicID)
+	d.Set("network_interface_id", nicID)
+	d.Set("server_id", serverID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func serverHasNIC(ctx context.Context, c *client.Client, projectID, serverID, nicID string) (bool, error) {
 	instResp := &dto.InstanceResponse{}
 	if _, err := c.Get(ctx, client.ApiPath.InstanceWithID(projectID, serverID), instResp, nil); err != nil {
@@ -251,3 +251,69 @@ func TestResourceNetworkInterfaceAttachmentCreate_APIError(t *testing.T) {
 		t.Fatal("expected error for attach API failure, got none")
 	}
 }
+
+func TestResourceNetworkInterfaceAttachmentImport(t *testing.T) {
+	inst := testInstance("nic-001")
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/srv-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceNetworkInterfaceAttachment()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	d.SetId("nic-001/srv-001")
+
+	results, err := res.Importer.StateContext(context.Background(), d, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	if d.Id() != "nic-001" {
+		t.Errorf("expected ID nic-001, got %s", d.Id())
+	}
+	if v := d.Get("network_interface_id").(string); v != "nic-001" {
+		t.Errorf("expected network_interface_id nic-001, got %s", v)
+	}
+	if v := d.Get("server_id").(string); v != "srv-001" {
+		t.Errorf("expected server_id srv-001, got %s", v)
+	}
+}
+
+func TestResourceNetworkInterfaceAttachmentImport_InvalidID(t *testing.T) {
+	res := ResourceNetworkInterfaceAttachment()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	d.SetId("nic-001")
+
+	if _, err := res.Importer.StateContext(context.Background(), d, nil); err == nil {
+		t.Fatal("expected error for import ID without instance ID, got nil")
+	}
+}
+
+func TestResourceNetworkInterfaceAttachmentImport_NotAttached(t *testing.T) {
+	inst := testInstance()
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/instances/srv-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.InstanceResponse{Instance: inst}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceNetworkInterfaceAttachment()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	d.SetId("nic-001/srv-001")
+
+	if _, err := res.Importer.StateContext(context.Background(), d, cfg); err == nil {
+		t.Fatal("expected error when network interface is not attached, got nil")
+	}
+}
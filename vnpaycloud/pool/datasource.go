@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -136,6 +137,8 @@ func dataSourcePoolRead(ctx context.Context, d *schema.ResourceData, meta interf
 func DataSourcePools() *schema.Resource {
 	memberElem := &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			"filter":        util.DataSourceFiltersSchema(),
+			"name_regex":    util.DataSourceNameRegexSchema(),
 			"id":            {Type: schema.TypeString, Computed: true},
 			"name":          {Type: schema.TypeString, Computed: true},
 			"address":       {Type: schema.TypeString, Computed: true},
@@ -180,14 +183,18 @@ func DataSourcePools() *schema.Resource {
 func dataSourcePoolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListPoolsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Pools(cfg.ProjectID), resp, nil)
+	allPools, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Pools(cfg.ProjectID), func(r *dto.ListPoolsResponse) []dto.Pool { return r.Pools })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_lb_pools: %s", err)
 	}
 
+	allPools, err = util.ApplyDataSourceFilters(d, allPools, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_lb_pools: %s", err)
+	}
+
 	var pools []map[string]interface{}
-	for _, p := range resp.Pools {
+	for _, p := range allPools {
 		pools = append(pools, map[string]interface{}{
 			"id":                  p.ID,
 			"name":                p.Name,
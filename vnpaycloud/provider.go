@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"terraform-provider-vnpaycloud/vnpaycloud/bucket"
 	"terraform-provider-vnpaycloud/vnpaycloud/certificate"
+	"terraform-provider-vnpaycloud/vnpaycloud/cloudinit"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/customergateway"
 	"terraform-provider-vnpaycloud/vnpaycloud/databaseflavor"
@@ -48,10 +49,12 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/serviceendpoint"
 	"terraform-provider-vnpaycloud/vnpaycloud/servicegateway"
 	"terraform-provider-vnpaycloud/vnpaycloud/snapshot"
+	"terraform-provider-vnpaycloud/vnpaycloud/snapshotpolicy"
 	"terraform-provider-vnpaycloud/vnpaycloud/subnet"
 	"terraform-provider-vnpaycloud/vnpaycloud/subnetsnat"
 	"terraform-provider-vnpaycloud/vnpaycloud/volume"
 	"terraform-provider-vnpaycloud/vnpaycloud/volumeattachment"
+	"terraform-provider-vnpaycloud/vnpaycloud/volumebackup"
 	"terraform-provider-vnpaycloud/vnpaycloud/volumetype"
 	"terraform-provider-vnpaycloud/vnpaycloud/vpc"
 	"terraform-provider-vnpaycloud/vnpaycloud/vpcpeering"
@@ -59,9 +62,11 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/vpngateway"
 	"terraform-provider-vnpaycloud/vnpaycloud/vpnpublicip"
 	"terraform-provider-vnpaycloud/vnpaycloud/workergroup"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Provider returns a schema.Provider for VNPAY Cloud.
@@ -87,6 +92,93 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VNPAYCLOUD_ZONE_ID", nil),
 				Description: "The availability zone ID. The provider resolves the project for your account in this zone.",
 			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with tags applied to every taggable resource managed by the provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tags merged into the tags of every taggable resource. Resource-level tags take precedence.",
+						},
+					},
+				},
+			},
+			"max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Default:      0.0,
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "Client-side limit on API requests per second, shared by all resource operations. 0 disables the limiter.",
+			},
+			"burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of requests the client-side limiter lets through at once before pacing to max_requests_per_second.",
+			},
+			"retry": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block for the HTTP retry policy. Omit to use the built-in defaults.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Total attempts for a request failing with a retryable status code or network error, including the first request.",
+						},
+						"base_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "1s",
+							ValidateFunc: validateDuration,
+							Description:  "Initial backoff between transient retries. Doubles on each retry up to max_backoff.",
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "30s",
+							ValidateFunc: validateDuration,
+							Description:  "Upper bound for the backoff between transient retries, including waits requested by a Retry-After header on a transient failure.",
+						},
+						"retryable_status_codes": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt, ValidateFunc: validation.IntBetween(400, 599)},
+							Description: "HTTP status codes treated as transient failures. Defaults to 502, 503 and 504.",
+						},
+						"retry_on_network_errors": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Retry requests failing with connection resets, refused connections or timeouts. POST requests are only retried when the connection could not be established.",
+						},
+						"rate_limit_max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Total attempts for a rate-limited request (429 / Too Many Requests), including the first request.",
+						},
+						"rate_limit_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "30s",
+							ValidateFunc: validateDuration,
+							Description:  "Backoff step between rate-limit retries. Grows linearly (1x, 2x, 3x, ...) with 0-25% jitter.",
+						},
+					},
+				},
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -105,10 +197,16 @@ func Provider() *schema.Provider {
 			"vnpaycloud_volumes":                           volume.DataSourceVolumes(),
 			"vnpaycloud_instance":                          instance.DataSourceInstance(),
 			"vnpaycloud_instances":                         instance.DataSourceInstances(),
+			"vnpaycloud_instance_console_output":           instance.DataSourceInstanceConsoleOutput(),
+			"vnpaycloud_instance_console_url":              instance.DataSourceInstanceConsoleURL(),
+			"vnpaycloud_cloudinit_config":                  cloudinit.DataSourceCloudInitConfig(),
 			"vnpaycloud_keypair":                           keypair.DataSourceKeyPair(),
 			"vnpaycloud_keypairs":                          keypair.DataSourceKeyPairs(),
 			"vnpaycloud_snapshot":                          snapshot.DataSourceSnapshot(),
 			"vnpaycloud_snapshots":                         snapshot.DataSourceSnapshots(),
+			"vnpaycloud_snapshot_policy_snapshots":         snapshotpolicy.DataSourceSnapshotPolicySnapshots(),
+			"vnpaycloud_volume_backup":                     volumebackup.DataSourceVolumeBackup(),
+			"vnpaycloud_volume_backups":                    volumebackup.DataSourceVolumeBackups(),
 			"vnpaycloud_internet_gateway":                  internetgateway.DataSourceInternetGateway(),
 			"vnpaycloud_internet_gateways":                 internetgateway.DataSourceInternetGateways(),
 			"vnpaycloud_service_gateway":                   servicegateway.DataSourceServiceGateway(),
@@ -195,9 +293,15 @@ func Provider() *schema.Provider {
 			"vnpaycloud_network_interface_attachment":     networkinterfaceattachment.ResourceNetworkInterfaceAttachment(),
 			"vnpaycloud_volume":                           volume.ResourceVolume(),
 			"vnpaycloud_volume_attachment":                volumeattachment.ResourceVolumeAttachment(),
+			"vnpaycloud_volume_snapshot_revert":           volume.ResourceVolumeSnapshotRevert(),
+			"vnpaycloud_volume_backup":                    volumebackup.ResourceVolumeBackup(),
 			"vnpaycloud_instance":                         instance.ResourceInstance(),
+			"vnpaycloud_instance_power_action":            instance.ResourceInstancePowerAction(),
+			"vnpaycloud_image":                            image.ResourceImage(),
 			"vnpaycloud_keypair":                          keypair.ResourceKeyPair(),
 			"vnpaycloud_snapshot":                         snapshot.ResourceSnapshot(),
+			"vnpaycloud_snapshot_copy":                    snapshot.ResourceSnapshotCopy(),
+			"vnpaycloud_snapshot_policy":                  snapshotpolicy.ResourceSnapshotPolicy(),
 			"vnpaycloud_internet_gateway":                 internetgateway.ResourceInternetGateway(),
 			"vnpaycloud_service_gateway":                  servicegateway.ResourceServiceGateway(),
 			"vnpaycloud_service_endpoint":                 serviceendpoint.ResourceServiceEndpoint(),
@@ -243,6 +347,10 @@ func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}
 	c, err := client.NewClient(ctx, &client.ClientConfig{
 		BaseURL: d.Get("base_url").(string),
 		Token:   d.Get("token").(string),
+		Retry:   expandProviderRetry(d.Get("retry").([]interface{})),
+
+		MaxRequestsPerSecond: d.Get("max_requests_per_second").(float64),
+		Burst:                d.Get("burst").(int),
 	})
 	if err != nil {
 		return nil, diag.FromErr(err)
@@ -263,11 +371,64 @@ func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}
 	}
 
 	cfg := &config.Config{
-		MutexKV:   mutexkv.NewMutexKV(),
-		Client:    c,
-		ProjectID: resolveResp.ProjectID,
-		ZoneID:    zoneID,
+		MutexKV:     mutexkv.NewMutexKV(),
+		Client:      c,
+		ProjectID:   resolveResp.ProjectID,
+		ZoneID:      zoneID,
+		DefaultTags: expandProviderDefaultTags(d.Get("default_tags").([]interface{})),
 	}
 
 	return cfg, nil
 }
+
+func expandProviderDefaultTags(raw []interface{}) map[string]string {
+	tags := make(map[string]string)
+	if len(raw) == 0 || raw[0] == nil {
+		return tags
+	}
+
+	m := raw[0].(map[string]interface{})
+	if v, ok := m["tags"].(map[string]interface{}); ok {
+		for k, val := range v {
+			tags[k] = val.(string)
+		}
+	}
+	return tags
+}
+
+func expandProviderRetry(raw []interface{}) *client.RetryPolicy {
+	if len(raw) == 0 || raw[0] == nil {
+		return nil
+	}
+
+	m := raw[0].(map[string]interface{})
+	policy := client.DefaultRetryPolicy()
+	policy.MaxAttempts = m["max_attempts"].(int)
+	policy.BaseBackoff, _ = time.ParseDuration(m["base_backoff"].(string))
+	policy.MaxBackoff, _ = time.ParseDuration(m["max_backoff"].(string))
+	policy.RetryNetworkErrors = m["retry_on_network_errors"].(bool)
+	policy.RateLimitMaxAttempts = m["rate_limit_max_attempts"].(int)
+	policy.RateLimitBackoff, _ = time.ParseDuration(m["rate_limit_backoff"].(string))
+
+	if v, ok := m["retryable_status_codes"].(*schema.Set); ok && v.Len() > 0 {
+		codes := make([]int, 0, v.Len())
+		for _, code := range v.List() {
+			codes = append(codes, code.(int))
+		}
+		policy.RetryableStatusCodes = codes
+	}
+
+	return policy
+}
+
+func validateDuration(v interface{}, k string) (ws []string, errs []error) {
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%q must be a valid duration (e.g. \"1s\", \"500ms\"): %s", k, err))
+		return
+	}
+	if d < 0 {
+		errs = append(errs, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
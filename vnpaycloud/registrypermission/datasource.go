@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +18,7 @@ func DataSourceRegistryPermissions() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRegistryPermissionsRead,
 		Schema: map[string]*schema.Schema{
+			"filter": util.DataSourceFiltersSchema(),
 			"permissions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -45,14 +47,18 @@ func DataSourceRegistryPermissions() *schema.Resource {
 func dataSourceRegistryPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListRegistryPermissionsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.RegistryPermissions(cfg.ProjectID), resp, nil)
+	allPermissions, err := client.ListAll(ctx, cfg.Client, client.ApiPath.RegistryPermissions(cfg.ProjectID), func(r *dto.ListRegistryPermissionsResponse) []dto.RegistryPermission { return r.Permissions })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_registry_permissions: %s", err)
 	}
 
-	permissions := make([]map[string]interface{}, 0, len(resp.Permissions))
-	for _, p := range resp.Permissions {
+	allPermissions, err = util.ApplyDataSourceFilters(d, allPermissions, "")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_registry_permissions: %s", err)
+	}
+
+	permissions := make([]map[string]interface{}, 0, len(allPermissions))
+	for _, p := range allPermissions {
 		permissions = append(permissions, map[string]interface{}{
 			"resource": p.Resource,
 			"action":   p.Action,
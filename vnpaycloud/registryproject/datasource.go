@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -82,6 +83,8 @@ func DataSourceRegistryProjects() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRegistryProjectsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"registries": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -133,14 +136,18 @@ func DataSourceRegistryProjects() *schema.Resource {
 func dataSourceRegistryProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListRegistryProjectsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.RegistryProjects(cfg.ProjectID), resp, nil)
+	allRegistries, err := client.ListAll(ctx, cfg.Client, client.ApiPath.RegistryProjects(cfg.ProjectID), func(r *dto.ListRegistryProjectsResponse) []dto.RegistryProject { return r.Registries })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_registry_projects: %s", err)
 	}
 
+	allRegistries, err = util.ApplyDataSourceFilters(d, allRegistries, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_registry_projects: %s", err)
+	}
+
 	var registries []map[string]interface{}
-	for _, r := range resp.Registries {
+	for _, r := range allRegistries {
 		registries = append(registries, map[string]interface{}{
 			"id":            r.ID,
 			"name":          r.Name,
@@ -7,6 +7,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func routeTableStateRefreshFunc(ctx context.Context, c *client.Client, projectID, routeTableID string) retry.StateRefreshFunc {
@@ -27,3 +28,72 @@ func routeTableStateRefreshFunc(ctx context.Context, c *client.Client, projectID
 		return &resp.RouteTable, resp.RouteTable.Status, nil
 	}
 }
+
+func expandRouteTableRoutes(raw []interface{}) []dto.Route {
+	routes := make([]dto.Route, 0, len(raw))
+	for _, r := range raw {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		routes = append(routes, dto.Route{
+			DestinationCIDR: m["destination_cidr"].(string),
+			TargetType:      m["target_type"].(string),
+			TargetID:        m["target_id"].(string),
+		})
+	}
+	return routes
+}
+
+func flattenRouteTableRoutes(routes []dto.Route) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(routes))
+	for _, r := range routes {
+		result = append(result, map[string]interface{}{
+			"destination_cidr": r.DestinationCIDR,
+			"target_type":      r.TargetType,
+			"target_id":        r.TargetID,
+		})
+	}
+	return result
+}
+
+// flattenRouteTableRoutesWithTargetName is flattenRouteTableRoutes plus the
+// computed target_name, for data sources.
+func flattenRouteTableRoutesWithTargetName(routes []dto.Route) []map[string]interface{} {
+	result := flattenRouteTableRoutes(routes)
+	for i, r := range routes {
+		result[i]["target_name"] = r.TargetName
+	}
+	return result
+}
+
+func expandRouteTableSubnetIDs(set *schema.Set) []string {
+	ids := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		ids = append(ids, v.(string))
+	}
+	return ids
+}
+
+// diffRouteTableRoutes returns the routes to add (new destinations and
+// destinations whose target changed) and the routes to remove (destinations
+// no longer present). Routes are keyed by destination CIDR.
+func diffRouteTableRoutes(oldRoutes, newRoutes []dto.Route) (add, remove []dto.Route) {
+	oldByDest := make(map[string]dto.Route, len(oldRoutes))
+	for _, r := range oldRoutes {
+		oldByDest[r.DestinationCIDR] = r
+	}
+	newByDest := make(map[string]bool, len(newRoutes))
+	for _, r := range newRoutes {
+		newByDest[r.DestinationCIDR] = true
+		if o, ok := oldByDest[r.DestinationCIDR]; !ok || o.TargetType != r.TargetType || o.TargetID != r.TargetID {
+			add = append(add, r)
+		}
+	}
+	for _, r := range oldRoutes {
+		if !newByDest[r.DestinationCIDR] {
+			remove = append(remove, r)
+		}
+	}
+	return add, remove
+}
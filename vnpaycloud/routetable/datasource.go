@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,8 +22,25 @@ func DataSourceRouteTable() *schema.Resource {
 			"target_type": {Type: schema.TypeString, Computed: true},
 			"target_name": {Type: schema.TypeString, Computed: true},
 			"name":        {Type: schema.TypeString, Computed: true},
-			"status":      {Type: schema.TypeString, Computed: true},
-			"created_at":  {Type: schema.TypeString, Computed: true},
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr": {Type: schema.TypeString, Computed: true},
+						"target_type":      {Type: schema.TypeString, Computed: true},
+						"target_id":        {Type: schema.TypeString, Computed: true},
+						"target_name":      {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+			"subnet_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"status":     {Type: schema.TypeString, Computed: true},
+			"created_at": {Type: schema.TypeString, Computed: true},
 		},
 	}
 }
@@ -45,6 +63,8 @@ func dataSourceRouteTableRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("target_type", resp.RouteTable.TargetType)
 	d.Set("target_name", resp.RouteTable.TargetName)
 	d.Set("name", resp.RouteTable.Name)
+	d.Set("routes", flattenRouteTableRoutesWithTargetName(resp.RouteTable.Routes))
+	d.Set("subnet_ids", resp.RouteTable.SubnetIDs)
 	d.Set("status", resp.RouteTable.Status)
 	d.Set("created_at", resp.RouteTable.CreatedAt)
 
@@ -55,6 +75,8 @@ func DataSourceRouteTables() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRouteTablesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -71,8 +93,25 @@ func DataSourceRouteTables() *schema.Resource {
 						"target_type": {Type: schema.TypeString, Computed: true},
 						"target_name": {Type: schema.TypeString, Computed: true},
 						"name":        {Type: schema.TypeString, Computed: true},
-						"status":      {Type: schema.TypeString, Computed: true},
-						"created_at":  {Type: schema.TypeString, Computed: true},
+						"routes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"destination_cidr": {Type: schema.TypeString, Computed: true},
+									"target_type":      {Type: schema.TypeString, Computed: true},
+									"target_id":        {Type: schema.TypeString, Computed: true},
+									"target_name":      {Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status":     {Type: schema.TypeString, Computed: true},
+						"created_at": {Type: schema.TypeString, Computed: true},
 					},
 				},
 			},
@@ -90,14 +129,18 @@ func dataSourceRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta
 		path += "?vpc_id=" + vpcID
 	}
 
-	resp := &dto.ListRouteTablesResponse{}
-	_, err := cfg.Client.Get(ctx, path, resp, nil)
+	routeTables, err := client.ListAll(ctx, cfg.Client, path, func(r *dto.ListRouteTablesResponse) []dto.RouteTable { return r.RouteTables })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_route_tables: %s", err)
 	}
 
+	routeTables, err = util.ApplyDataSourceFilters(d, routeTables, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_route_tables: %s", err)
+	}
+
 	var rts []map[string]interface{}
-	for _, rt := range resp.RouteTables {
+	for _, rt := range routeTables {
 		rts = append(rts, map[string]interface{}{
 			"id":          rt.ID,
 			"vpc_id":      rt.VpcID,
@@ -106,6 +149,8 @@ func dataSourceRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta
 			"target_type": rt.TargetType,
 			"target_name": rt.TargetName,
 			"name":        rt.Name,
+			"routes":      flattenRouteTableRoutesWithTargetName(rt.Routes),
+			"subnet_ids":  rt.SubnetIDs,
 			"status":      rt.Status,
 			"created_at":  rt.CreatedAt,
 		})
@@ -2,6 +2,7 @@ package routetable
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
@@ -12,15 +13,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceRouteTable() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceRouteTableCreate,
 		ReadContext:   resourceRouteTableRead,
+		UpdateContext: resourceRouteTableUpdate,
 		DeleteContext: resourceRouteTableDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceRouteTableCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 		Schema: map[string]*schema.Schema{
@@ -30,22 +38,51 @@ func ResourceRouteTable() *schema.Resource {
 				ForceNew: true,
 			},
 			"dest_cidr": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"dest_cidr", "route"},
+				RequiredWith: []string{"target_id", "target_type"},
 			},
 			"target_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"dest_cidr", "target_type"},
 			},
 			"target_type": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"dest_cidr", "target_id"},
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsCIDR,
+						},
+						"target_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"target_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"subnet_ids": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"dest_cidr"},
 			},
 			"name": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"target_name": {
@@ -72,6 +109,9 @@ func resourceRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta
 		DestCIDR:   d.Get("dest_cidr").(string),
 		TargetID:   d.Get("target_id").(string),
 		TargetType: d.Get("target_type").(string),
+		Name:       d.Get("name").(string),
+		Routes:     expandRouteTableRoutes(d.Get("route").(*schema.Set).List()),
+		SubnetIDs:  expandRouteTableSubnetIDs(d.Get("subnet_ids").(*schema.Set)),
 	}
 
 	tflog.Debug(ctx, "vnpaycloud_route_table create options", map[string]interface{}{"create_opts": createOpts})
@@ -131,11 +171,128 @@ func resourceRouteTableRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("name", rt.Name)
 	d.Set("target_name", rt.TargetName)
 	d.Set("status", rt.Status)
+	d.Set("route", flattenRouteTableRoutes(rt.Routes))
+	d.Set("subnet_ids", rt.SubnetIDs)
 	d.Set("created_at", rt.CreatedAt)
 
 	return nil
 }
 
+// resourceRouteTableUpdate changes the table in place so traffic keeps
+// flowing while routes are switched over. New and retargeted routes are
+// added before stale ones are removed, and only the routes and subnet
+// associations that changed are sent.
+func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	changed := false
+
+	if d.HasChanges("name", "dest_cidr", "target_id", "target_type") {
+		updateOpts := dto.UpdateRouteTableRequest{
+			DestCIDR:   d.Get("dest_cidr").(string),
+			TargetID:   d.Get("target_id").(string),
+			TargetType: d.Get("target_type").(string),
+		}
+		if d.HasChange("name") {
+			updateOpts.Name = d.Get("name").(string)
+		}
+
+		tflog.Debug(ctx, "vnpaycloud_route_table update options", map[string]interface{}{"update_opts": updateOpts})
+
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.RouteTableWithID(cfg.ProjectID, d.Id()), updateOpts, nil, nil); err != nil {
+			return diag.Errorf("Error updating vnpaycloud_route_table %s: %s", d.Id(), err)
+		}
+		changed = true
+	}
+
+	if d.HasChange("route") {
+		o, n := d.GetChange("route")
+		add, remove := diffRouteTableRoutes(expandRouteTableRoutes(o.(*schema.Set).List()), expandRouteTableRoutes(n.(*schema.Set).List()))
+
+		tflog.Debug(ctx, "vnpaycloud_route_table update routes", map[string]interface{}{"add": add, "remove": remove})
+
+		if len(add) > 0 {
+			if _, err := cfg.Client.Post(ctx, client.ApiPath.RouteTableAddRoutes(cfg.ProjectID, d.Id()), dto.RouteTableRoutesRequest{Routes: add}, nil, nil); err != nil {
+				return diag.Errorf("Error adding routes to vnpaycloud_route_table %s: %s", d.Id(), err)
+			}
+			changed = true
+		}
+		if len(remove) > 0 {
+			if _, err := cfg.Client.Post(ctx, client.ApiPath.RouteTableRemoveRoutes(cfg.ProjectID, d.Id()), dto.RouteTableRoutesRequest{Routes: remove}, nil, nil); err != nil {
+				return diag.Errorf("Error removing routes from vnpaycloud_route_table %s: %s", d.Id(), err)
+			}
+			changed = true
+		}
+	}
+
+	if d.HasChange("subnet_ids") {
+		o, n := d.GetChange("subnet_ids")
+		oldSet, newSet := o.(*schema.Set), n.(*schema.Set)
+
+		for _, subnetID := range expandRouteTableSubnetIDs(newSet.Difference(oldSet)) {
+			tflog.Debug(ctx, "vnpaycloud_route_table associate subnet", map[string]interface{}{"id": d.Id(), "subnet_id": subnetID})
+			if _, err := cfg.Client.Post(ctx, client.ApiPath.RouteTableAssociateSubnet(cfg.ProjectID, d.Id()), dto.RouteTableSubnetRequest{SubnetID: subnetID}, nil, nil); err != nil {
+				return diag.Errorf("Error associating subnet %s with vnpaycloud_route_table %s: %s", subnetID, d.Id(), err)
+			}
+			changed = true
+		}
+		for _, subnetID := range expandRouteTableSubnetIDs(oldSet.Difference(newSet)) {
+			tflog.Debug(ctx, "vnpaycloud_route_table disassociate subnet", map[string]interface{}{"id": d.Id(), "subnet_id": subnetID})
+			if _, err := cfg.Client.Post(ctx, client.ApiPath.RouteTableDisassociateSubnet(cfg.ProjectID, d.Id()), dto.RouteTableSubnetRequest{SubnetID: subnetID}, nil, nil); err != nil {
+				if !client.ResponseCodeIs(err, http.StatusNotFound) {
+					return diag.Errorf("Error disassociating subnet %s from vnpaycloud_route_table %s: %s", subnetID, d.Id(), err)
+				}
+			}
+			changed = true
+		}
+	}
+
+	if changed {
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"updating"},
+			Target:     []string{"active", "created"},
+			Refresh:    routeTableStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("Error waiting for vnpaycloud_route_table %s to become ready after update: %s", d.Id(), err)
+		}
+	}
+
+	return resourceRouteTableRead(ctx, d, meta)
+}
+
+// resourceRouteTableCustomizeDiff rejects two route blocks for the same
+// destination, which the backend would otherwise collapse into one. It also
+// replaces the table when it switches between single-route and route table
+// mode, since the single route cannot be cleared in place.
+func resourceRouteTableCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() != "" && d.HasChange("dest_cidr") {
+		o, n := d.GetChange("dest_cidr")
+		wasSingle := o.(string) != ""
+		isSingle := n.(string) != "" || !d.NewValueKnown("dest_cidr")
+		if wasSingle != isSingle {
+			if err := d.ForceNew("dest_cidr"); err != nil {
+				return err
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, r := range expandRouteTableRoutes(d.Get("route").(*schema.Set).List()) {
+		if r.DestinationCIDR == "" {
+			continue
+		}
+		if seen[r.DestinationCIDR] {
+			return fmt.Errorf("duplicate route for destination_cidr %s", r.DestinationCIDR)
+		}
+		seen[r.DestinationCIDR] = true
+	}
+	return nil
+}
+
 func resourceRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
@@ -2,13 +2,17 @@ package routetable
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
 
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // testRouteTable returns a fully populated dto.RouteTable for use in tests.
@@ -213,3 +217,363 @@ func TestResourceRouteTableDelete(t *testing.T) {
 		t.Error("expected DELETE to have been called")
 	}
 }
+
+func TestResourceRouteTableUpdate(t *testing.T) {
+	rt := testRouteTable()
+	rt.DestCIDR = "10.2.0.0/16"
+	rt.TargetID = "pgw-002"
+
+	var putBody dto.UpdateRouteTableRequest
+	putCalled := false
+	deleteCalled := false
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Pattern: "/v2/iac/projects/test-project-id/route-tables/rt-001",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPut:
+					putCalled = true
+					if err := json.NewDecoder(r.Body).Decode(&putBody); err != nil {
+						t.Errorf("failed to decode request body: %v", err)
+					}
+					w.WriteHeader(http.StatusOK)
+				case http.MethodGet:
+					testhelpers.JSONHandler(t, http.StatusOK, dto.RouteTableResponse{RouteTable: rt})(w, r)
+				case http.MethodDelete:
+					deleteCalled = true
+					w.WriteHeader(http.StatusOK)
+				default:
+					http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				}
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceRouteTable()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"vpc_id":      "vpc-001",
+		"dest_cidr":   "10.2.0.0/16",
+		"target_id":   "pgw-002",
+		"target_type": "private_gateway",
+	})
+	d.SetId("rt-001")
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !putCalled {
+		t.Error("expected PUT to have been called")
+	}
+	if deleteCalled {
+		t.Error("expected route to be updated in place, but DELETE was called")
+	}
+	if putBody.DestCIDR != "10.2.0.0/16" {
+		t.Errorf("expected destCidr 10.2.0.0/16 in request, got %s", putBody.DestCIDR)
+	}
+	if putBody.TargetID != "pgw-002" {
+		t.Errorf("expected targetId pgw-002 in request, got %s", putBody.TargetID)
+	}
+	if d.Id() != "rt-001" {
+		t.Errorf("expected ID to remain rt-001, got %s", d.Id())
+	}
+	if v := d.Get("dest_cidr").(string); v != "10.2.0.0/16" {
+		t.Errorf("expected dest_cidr 10.2.0.0/16, got %s", v)
+	}
+}
+
+func TestResourceRouteTableSchema_InPlaceFields(t *testing.T) {
+	res := ResourceRouteTable()
+
+	for _, k := range []string{"dest_cidr", "target_id", "target_type", "name"} {
+		if res.Schema[k].ForceNew {
+			t.Errorf("expected %s to be updatable in place", k)
+		}
+	}
+	if !res.Schema["vpc_id"].ForceNew {
+		t.Error("expected vpc_id to force a new route")
+	}
+}
+
+// testMultiRouteTable returns a named route table with inline routes.
+func testMultiRouteTable() dto.RouteTable {
+	return dto.RouteTable{
+		ID:    "rt-002",
+		VpcID: "vpc-001",
+		Name:  "app-routes",
+		Routes: []dto.Route{
+			{DestinationCIDR: "0.0.0.0/0", TargetType: "internet_gateway", TargetID: "igw-001"},
+			{DestinationCIDR: "192.168.0.0/16", TargetType: "peering_connection", TargetID: "pc-001", TargetName: "peer"},
+		},
+		SubnetIDs: []string{"subnet-001"},
+		Status:    "active",
+		CreatedAt: "2025-01-15T10:00:00Z",
+		ProjectID: testhelpers.TestProjectID,
+		ZoneID:    testhelpers.TestZoneID,
+	}
+}
+
+func TestResourceRouteTableCreate_MultiRoute(t *testing.T) {
+	rt := testMultiRouteTable()
+	var createBody dto.CreateRouteTableRequest
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/route-tables",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&createBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.RouteTableResponse{RouteTable: rt})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/route-tables/rt-002",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.RouteTableResponse{RouteTable: rt}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceRouteTable()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"vpc_id": "vpc-001",
+		"name":   "app-routes",
+		"route": []interface{}{
+			map[string]interface{}{"destination_cidr": "0.0.0.0/0", "target_type": "internet_gateway", "target_id": "igw-001"},
+			map[string]interface{}{"destination_cidr": "192.168.0.0/16", "target_type": "peering_connection", "target_id": "pc-001"},
+		},
+		"subnet_ids": []interface{}{"subnet-001"},
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if len(createBody.Routes) != 2 {
+		t.Errorf("expected 2 routes in create request, got %d", len(createBody.Routes))
+	}
+	if createBody.DestCIDR != "" {
+		t.Errorf("expected no destCidr in create request, got %s", createBody.DestCIDR)
+	}
+	if len(createBody.SubnetIDs) != 1 || createBody.SubnetIDs[0] != "subnet-001" {
+		t.Errorf("expected subnetIds [subnet-001] in create request, got %v", createBody.SubnetIDs)
+	}
+	if d.Id() != "rt-002" {
+		t.Errorf("expected ID rt-002, got %s", d.Id())
+	}
+	if v := d.Get("route").(*schema.Set).Len(); v != 2 {
+		t.Errorf("expected 2 routes, got %d", v)
+	}
+	if v := d.Get("subnet_ids").(*schema.Set).Len(); v != 1 {
+		t.Errorf("expected 1 subnet association, got %d", v)
+	}
+}
+
+func TestResourceRouteTableRead_DetectsExternalRoutes(t *testing.T) {
+	rt := testMultiRouteTable()
+	rt.Routes = append(rt.Routes, dto.Route{DestinationCIDR: "10.9.0.0/16", TargetType: "vpn_gateway", TargetID: "vgw-001"})
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/route-tables/rt-002",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.RouteTableResponse{RouteTable: rt}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceRouteTable()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"vpc_id": "vpc-001",
+	})
+	d.SetId("rt-002")
+
+	diags := res.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	found := false
+	for _, r := range d.Get("route").(*schema.Set).List() {
+		if r.(map[string]interface{})["destination_cidr"] == "10.9.0.0/16" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected route added outside Terraform to be read into state")
+	}
+}
+
+func TestResourceRouteTableUpdate_Routes(t *testing.T) {
+	rt := testMultiRouteTable()
+
+	var mu sync.Mutex
+	var calls []string
+	var addBody dto.RouteTableRoutesRequest
+	var associated dto.RouteTableSubnetRequest
+
+	record := func(name string, body interface{}) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			calls = append(calls, name)
+			mu.Unlock()
+			if body != nil {
+				if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/route-tables/rt-002/add-routes",
+			Handler: record("add-routes", &addBody),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/route-tables/rt-002/remove-routes",
+			Handler: record("remove-routes", nil),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/route-tables/rt-002/associate-subnet",
+			Handler: record("associate-subnet", &associated),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/route-tables/rt-002",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.RouteTableResponse{RouteTable: rt}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceRouteTable()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"vpc_id": "vpc-001",
+		"route": []interface{}{
+			map[string]interface{}{"destination_cidr": "0.0.0.0/0", "target_type": "internet_gateway", "target_id": "igw-001"},
+		},
+		"subnet_ids": []interface{}{"subnet-001"},
+	})
+	d.SetId("rt-002")
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if strings.Join(calls, ",") != "add-routes,associate-subnet" {
+		t.Errorf("expected add-routes then associate-subnet, got %v", calls)
+	}
+	if len(addBody.Routes) != 1 || addBody.Routes[0].DestinationCIDR != "0.0.0.0/0" {
+		t.Errorf("expected only the new route to be added, got %+v", addBody.Routes)
+	}
+	if associated.SubnetID != "subnet-001" {
+		t.Errorf("expected subnet-001 to be associated, got %s", associated.SubnetID)
+	}
+}
+
+func TestDiffRouteTableRoutes(t *testing.T) {
+	oldRoutes := []dto.Route{
+		{DestinationCIDR: "0.0.0.0/0", TargetType: "internet_gateway", TargetID: "igw-001"},
+		{DestinationCIDR: "10.1.0.0/16", TargetType: "vpn_gateway", TargetID: "vgw-001"},
+		{DestinationCIDR: "10.2.0.0/16", TargetType: "vpn_gateway", TargetID: "vgw-001"},
+	}
+	newRoutes := []dto.Route{
+		{DestinationCIDR: "0.0.0.0/0", TargetType: "internet_gateway", TargetID: "igw-001"},
+		{DestinationCIDR: "10.1.0.0/16", TargetType: "vpn_gateway", TargetID: "vgw-002"},
+		{DestinationCIDR: "10.3.0.0/16", TargetType: "vpn_gateway", TargetID: "vgw-001"},
+	}
+
+	add, remove := diffRouteTableRoutes(oldRoutes, newRoutes)
+
+	if len(add) != 2 || add[0].DestinationCIDR != "10.1.0.0/16" || add[1].DestinationCIDR != "10.3.0.0/16" {
+		t.Errorf("expected retargeted 10.1.0.0/16 and new 10.3.0.0/16 to be added, got %+v", add)
+	}
+	if len(remove) != 1 || remove[0].DestinationCIDR != "10.2.0.0/16" {
+		t.Errorf("expected only 10.2.0.0/16 to be removed, got %+v", remove)
+	}
+}
+
+func TestResourceRouteTableCustomizeDiff_DuplicateDestination(t *testing.T) {
+	res := ResourceRouteTable()
+	raw := map[string]interface{}{
+		"vpc_id": "vpc-001",
+		"route": []interface{}{
+			map[string]interface{}{"destination_cidr": "10.1.0.0/16", "target_type": "vpn_gateway", "target_id": "vgw-001"},
+			map[string]interface{}{"destination_cidr": "10.1.0.0/16", "target_type": "vpn_gateway", "target_id": "vgw-002"},
+		},
+	}
+
+	_, err := res.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+	if err == nil {
+		t.Fatal("expected error for duplicate destination_cidr, got nil")
+	}
+	if !strings.Contains(err.Error(), "duplicate route") {
+		t.Errorf("expected duplicate route error, got: %v", err)
+	}
+}
+
+func TestResourceRouteTableCustomizeDiff_ModeSwitch(t *testing.T) {
+	singleState := map[string]string{
+		"id":          "rt-001",
+		"vpc_id":      "vpc-001",
+		"dest_cidr":   "10.1.0.0/16",
+		"target_id":   "pgw-001",
+		"target_type": "private_gateway",
+	}
+	tableState := map[string]string{
+		"id":                       "rt-001",
+		"vpc_id":                   "vpc-001",
+		"route.#":                  "1",
+		"route.0.destination_cidr": "10.1.0.0/16",
+		"route.0.target_type":      "private_gateway",
+		"route.0.target_id":        "pgw-001",
+	}
+	single := map[string]interface{}{
+		"vpc_id":      "vpc-001",
+		"dest_cidr":   "10.2.0.0/16",
+		"target_id":   "pgw-002",
+		"target_type": "private_gateway",
+	}
+	table := map[string]interface{}{
+		"vpc_id": "vpc-001",
+		"route": []interface{}{
+			map[string]interface{}{"destination_cidr": "10.1.0.0/16", "target_type": "private_gateway", "target_id": "pgw-001"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		state       map[string]string
+		raw         map[string]interface{}
+		requiresNew bool
+	}{
+		{"single route retargeted", singleState, single, false},
+		{"single route to route table", singleState, table, true},
+		{"route table to single route", tableState, single, true},
+	}
+
+	res := ResourceRouteTable()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &terraform.InstanceState{ID: "rt-001", Attributes: tt.state}
+			diff, err := res.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tt.raw), nil)
+			if err != nil {
+				t.Fatalf("unexpected diff error: %v", err)
+			}
+			if diff.RequiresNew() != tt.requiresNew {
+				t.Errorf("expected RequiresNew %v, got %v", tt.requiresNew, diff.RequiresNew())
+			}
+		})
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
 	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func securityGroupStateRefreshFunc(ctx context.Context, c *client.Client, projectID, sgID string) retry.StateRefreshFunc {
@@ -31,3 +34,190 @@ func securityGroupStateRefreshFunc(ctx context.Context, c *client.Client, projec
 		return sgResp.SecurityGroup, sgResp.SecurityGroup.Status, nil
 	}
 }
+
+// securityGroupRuleSchema describes a rule in the computed rules list of the
+// security group resource and data source.
+func securityGroupRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id":                {Type: schema.TypeString, Computed: true},
+			"security_group_id": {Type: schema.TypeString, Computed: true},
+			"direction":         {Type: schema.TypeString, Computed: true},
+			"protocol":          {Type: schema.TypeString, Computed: true},
+			"ethertype":         {Type: schema.TypeString, Computed: true},
+			"port_range_min":    {Type: schema.TypeInt, Computed: true},
+			"port_range_max":    {Type: schema.TypeInt, Computed: true},
+			"remote_ip_prefix":  {Type: schema.TypeString, Computed: true},
+			"remote_group_id":   {Type: schema.TypeString, Computed: true},
+			"description":       {Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
+func flattenSecurityGroupRules(rules []dto.SecurityGroupRule) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(rules))
+	for i, r := range rules {
+		result[i] = map[string]interface{}{
+			"id":                r.ID,
+			"security_group_id": r.SecurityGroupID,
+			"direction":         r.Direction,
+			"protocol":          r.Protocol,
+			"ethertype":         r.EtherType,
+			"port_range_min":    r.PortRangeMin,
+			"port_range_max":    r.PortRangeMax,
+			"remote_ip_prefix":  r.RemoteIPPrefix,
+			"remote_group_id":   r.RemoteGroupID,
+			"description":       r.Description,
+		}
+	}
+	return result
+}
+
+// securityGroupRuleDirections are the inline rule blocks, named after the
+// direction of the rules they hold.
+var securityGroupRuleDirections = []string{"ingress", "egress"}
+
+// securityGroupInlineRuleSchema describes an ingress or egress block.
+func securityGroupInlineRuleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ethertype": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "IPv4",
+				ValidateFunc: validation.StringInSlice([]string{"IPv4", "IPv6"}, false),
+			},
+			"port_range_min": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"port_range_max": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"remote_ip_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+			"remote_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func expandSecurityGroupInlineRules(raw []interface{}, direction string) []dto.SecurityGroupRule {
+	rules := make([]dto.SecurityGroupRule, 0, len(raw))
+	for _, r := range raw {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rules = append(rules, dto.SecurityGroupRule{
+			Direction:      direction,
+			Protocol:       m["protocol"].(string),
+			EtherType:      m["ethertype"].(string),
+			PortRangeMin:   int32(m["port_range_min"].(int)),
+			PortRangeMax:   int32(m["port_range_max"].(int)),
+			RemoteIPPrefix: m["remote_ip_prefix"].(string),
+			RemoteGroupID:  m["remote_group_id"].(string),
+			Description:    m["description"].(string),
+		})
+	}
+	return rules
+}
+
+func flattenSecurityGroupInlineRules(rules []dto.SecurityGroupRule, direction string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(rules))
+	for _, r := range rules {
+		if r.Direction != direction {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"protocol":         r.Protocol,
+			"ethertype":        r.EtherType,
+			"port_range_min":   r.PortRangeMin,
+			"port_range_max":   r.PortRangeMax,
+			"remote_ip_prefix": r.RemoteIPPrefix,
+			"remote_group_id":  r.RemoteGroupID,
+			"description":      r.Description,
+		})
+	}
+	return result
+}
+
+// securityGroupRuleKey identifies a rule by the fields the backend uses to
+// reject duplicates. The description is not part of it, as it can be
+// changed in place.
+func securityGroupRuleKey(r dto.SecurityGroupRule) string {
+	return fmt.Sprintf("%s/%s/%s/%d/%d/%s/%s", r.Direction, r.EtherType, r.Protocol,
+		r.PortRangeMin, r.PortRangeMax, r.RemoteIPPrefix, r.RemoteGroupID)
+}
+
+// diffSecurityGroupRules compares the rules of a security group with the
+// desired ones. It returns the rules to add, the existing rules whose
+// description changed (carrying the new description), and the existing rules
+// to remove, including any created outside Terraform.
+func diffSecurityGroupRules(current, desired []dto.SecurityGroupRule) (add, update, remove []dto.SecurityGroupRule) {
+	currentByKey := make(map[string]dto.SecurityGroupRule, len(current))
+	for _, r := range current {
+		currentByKey[securityGroupRuleKey(r)] = r
+	}
+	desiredByKey := make(map[string]bool, len(desired))
+	for _, r := range desired {
+		key := securityGroupRuleKey(r)
+		desiredByKey[key] = true
+		c, ok := currentByKey[key]
+		if !ok {
+			add = append(add, r)
+			continue
+		}
+		if c.Description != r.Description {
+			c.Description = r.Description
+			update = append(update, c)
+		}
+	}
+	for _, r := range current {
+		if !desiredByKey[securityGroupRuleKey(r)] {
+			remove = append(remove, r)
+		}
+	}
+	return add, update, remove
+}
+
+// validateSecurityGroupInlineRules checks that every ingress and egress block
+// in the raw configuration sets exactly one of remote_ip_prefix and
+// remote_group_id. Unknown values count as set.
+func validateSecurityGroupInlineRules(raw cty.Value) error {
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	for _, direction := range securityGroupRuleDirections {
+		blocks := raw.GetAttr(direction)
+		if blocks.IsNull() || !blocks.IsKnown() {
+			continue
+		}
+		for it := blocks.ElementIterator(); it.Next(); {
+			_, block := it.Element()
+			if block.IsNull() || !block.IsKnown() {
+				continue
+			}
+			hasPrefix := !block.GetAttr("remote_ip_prefix").IsNull()
+			hasGroup := !block.GetAttr("remote_group_id").IsNull()
+			if hasPrefix == hasGroup {
+				return fmt.Errorf("each %s block must set exactly one of remote_ip_prefix or remote_group_id", direction)
+			}
+		}
+	}
+	return nil
+}
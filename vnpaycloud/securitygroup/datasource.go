@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -36,18 +37,7 @@ func DataSourceSecurityGroup() *schema.Resource {
 			"rules": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id":                {Type: schema.TypeString, Computed: true},
-						"security_group_id": {Type: schema.TypeString, Computed: true},
-						"direction":         {Type: schema.TypeString, Computed: true},
-						"protocol":          {Type: schema.TypeString, Computed: true},
-						"ethertype":         {Type: schema.TypeString, Computed: true},
-						"port_range_min":    {Type: schema.TypeInt, Computed: true},
-						"port_range_max":    {Type: schema.TypeInt, Computed: true},
-						"remote_ip_prefix":  {Type: schema.TypeString, Computed: true},
-					},
-				},
+				Elem:     securityGroupRuleSchema(),
 			},
 			"enable_log": {
 				Type:     schema.TypeBool,
@@ -78,15 +68,14 @@ func dataSourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// List and filter client-side
-	listResp := &dto.ListSecurityGroupsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.SecurityGroups(cfg.ProjectID), listResp, nil)
+	securityGroups, err := client.ListAll(ctx, cfg.Client, client.ApiPath.SecurityGroups(cfg.ProjectID), func(r *dto.ListSecurityGroupsResponse) []dto.SecurityGroup { return r.SecurityGroups })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_security_group: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, sg := range listResp.SecurityGroups {
+	for _, sg := range securityGroups {
 		if nameOk && sg.Name != nameFilter.(string) {
 			continue
 		}
@@ -105,20 +94,7 @@ func setSecurityGroupData(d *schema.ResourceData, sg *dto.SecurityGroup) diag.Di
 	d.Set("can_enable_log", sg.CanEnableLog)
 	d.Set("created_at", sg.CreatedAt)
 
-	rules := make([]map[string]interface{}, len(sg.Rules))
-	for i, r := range sg.Rules {
-		rules[i] = map[string]interface{}{
-			"id":                r.ID,
-			"security_group_id": r.SecurityGroupID,
-			"direction":         r.Direction,
-			"protocol":          r.Protocol,
-			"ethertype":         r.EtherType,
-			"port_range_min":    r.PortRangeMin,
-			"port_range_max":    r.PortRangeMax,
-			"remote_ip_prefix":  r.RemoteIPPrefix,
-		}
-	}
-	d.Set("rules", rules)
+	d.Set("rules", flattenSecurityGroupRules(sg.Rules))
 
 	return nil
 }
@@ -127,6 +103,8 @@ func DataSourceSecurityGroups() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceSecurityGroupsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"security_groups": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -147,14 +125,18 @@ func DataSourceSecurityGroups() *schema.Resource {
 func dataSourceSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListSecurityGroupsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.SecurityGroups(cfg.ProjectID), listResp, nil)
+	securityGroups, err := client.ListAll(ctx, cfg.Client, client.ApiPath.SecurityGroups(cfg.ProjectID), func(r *dto.ListSecurityGroupsResponse) []dto.SecurityGroup { return r.SecurityGroups })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_security_groups: %s", err)
 	}
 
+	securityGroups, err = util.ApplyDataSourceFilters(d, securityGroups, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_security_groups: %s", err)
+	}
+
 	var sgs []map[string]interface{}
-	for _, sg := range listResp.SecurityGroups {
+	for _, sg := range securityGroups {
 		sgs = append(sgs, map[string]interface{}{
 			"id":          sg.ID,
 			"name":        sg.Name,
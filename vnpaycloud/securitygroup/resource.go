@@ -3,6 +3,7 @@ package securitygroup
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
@@ -22,17 +23,9 @@ func ResourceSecurityGroup() *schema.Resource {
 		UpdateContext: resourceSecurityGroupUpdate,
 		DeleteContext: resourceSecurityGroupDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
-		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
-			if d.Id() == "" {
-				return nil
-			}
-			if d.HasChange("enable_log") && !d.Get("can_enable_log").(bool) {
-				return fmt.Errorf("enable_log cannot be changed: network logging is not supported for this security group in this zone (can_enable_log = false)")
-			}
-			return nil
+			StateContext: resourceSecurityGroupImport,
 		},
+		CustomizeDiff: resourceSecurityGroupCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -49,18 +42,24 @@ func ResourceSecurityGroup() *schema.Resource {
 			"rules": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"id":                {Type: schema.TypeString, Computed: true},
-						"security_group_id": {Type: schema.TypeString, Computed: true},
-						"direction":         {Type: schema.TypeString, Computed: true},
-						"protocol":          {Type: schema.TypeString, Computed: true},
-						"ethertype":         {Type: schema.TypeString, Computed: true},
-						"port_range_min":    {Type: schema.TypeInt, Computed: true},
-						"port_range_max":    {Type: schema.TypeInt, Computed: true},
-						"remote_ip_prefix":  {Type: schema.TypeString, Computed: true},
-					},
-				},
+				Elem:     securityGroupRuleSchema(),
+			},
+			"ingress": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     securityGroupInlineRuleSchema(),
+			},
+			"egress": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     securityGroupInlineRuleSchema(),
+			},
+			"revoke_rules_on_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 			"enable_log": {
 				Type:     schema.TypeBool,
@@ -129,6 +128,16 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// The group starts with default egress rules; they are kept unless egress
+	// blocks are configured.
+	for _, direction := range securityGroupRuleDirections {
+		if rules := d.Get(direction).(*schema.Set); rules.Len() > 0 {
+			if err := syncSecurityGroupRules(ctx, cfg, d.Id(), direction, expandSecurityGroupInlineRules(rules.List(), direction)); err != nil {
+				return diag.Errorf("Error creating %s rules for vnpaycloud_security_group %s: %s", direction, d.Id(), err)
+			}
+		}
+	}
+
 	return resourceSecurityGroupRead(ctx, d, meta)
 }
 
@@ -149,20 +158,9 @@ func resourceSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("can_enable_log", sgResp.SecurityGroup.CanEnableLog)
 	d.Set("created_at", sgResp.SecurityGroup.CreatedAt)
 
-	rules := make([]map[string]interface{}, len(sgResp.SecurityGroup.Rules))
-	for i, r := range sgResp.SecurityGroup.Rules {
-		rules[i] = map[string]interface{}{
-			"id":                r.ID,
-			"security_group_id": r.SecurityGroupID,
-			"direction":         r.Direction,
-			"protocol":          r.Protocol,
-			"ethertype":         r.EtherType,
-			"port_range_min":    r.PortRangeMin,
-			"port_range_max":    r.PortRangeMax,
-			"remote_ip_prefix":  r.RemoteIPPrefix,
-		}
-	}
-	d.Set("rules", rules)
+	d.Set("rules", flattenSecurityGroupRules(sgResp.SecurityGroup.Rules))
+	d.Set("ingress", flattenSecurityGroupInlineRules(sgResp.SecurityGroup.Rules, "ingress"))
+	d.Set("egress", flattenSecurityGroupInlineRules(sgResp.SecurityGroup.Rules, "egress"))
 
 	return nil
 }
@@ -182,9 +180,41 @@ func resourceSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// Removing every block of a direction leaves its rules unmanaged rather
+	// than deleting them, so only a non-empty set is applied.
+	for _, direction := range securityGroupRuleDirections {
+		if rules := d.Get(direction).(*schema.Set); d.HasChange(direction) && rules.Len() > 0 {
+			if err := syncSecurityGroupRules(ctx, cfg, d.Id(), direction, expandSecurityGroupInlineRules(rules.List(), direction)); err != nil {
+				return diag.Errorf("Error updating %s rules for vnpaycloud_security_group %s: %s", direction, d.Id(), err)
+			}
+		}
+	}
+
 	return resourceSecurityGroupRead(ctx, d, meta)
 }
 
+// resourceSecurityGroupCustomizeDiff rejects inline rules without exactly one
+// remote, and enable_log changes in zones without network logging.
+func resourceSecurityGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if err := validateSecurityGroupInlineRules(d.GetRawConfig()); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("enable_log") && !d.Get("can_enable_log").(bool) {
+		return fmt.Errorf("enable_log cannot be changed: network logging is not supported for this security group in this zone (can_enable_log = false)")
+	}
+	return nil
+}
+
+// resourceSecurityGroupImport sets revoke_rules_on_delete to its default,
+// since it only exists in Terraform.
+func resourceSecurityGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("revoke_rules_on_delete", false)
+	return []*schema.ResourceData{d}, nil
+}
+
 func updateSecurityGroup(ctx context.Context, d *schema.ResourceData, cfg *config.Config) error {
 	updateOpts := dto.UpdateSecurityGroupRequest{
 		Name:        d.Get("name").(string),
@@ -206,6 +236,70 @@ func readSecurityGroup(ctx context.Context, cfg *config.Config, id string) (*dto
 	return &sgResp.SecurityGroup, nil
 }
 
+// syncSecurityGroupRules makes the rules of one direction match desired. New
+// rules are added before stale ones are removed, so traffic allowed by both
+// keeps flowing. Rules added outside Terraform are removed too.
+func syncSecurityGroupRules(ctx context.Context, cfg *config.Config, id, direction string, desired []dto.SecurityGroupRule) error {
+	sg, err := readSecurityGroup(ctx, cfg, id)
+	if err != nil {
+		return err
+	}
+
+	var current []dto.SecurityGroupRule
+	for _, r := range sg.Rules {
+		if r.Direction == direction {
+			current = append(current, r)
+		}
+	}
+
+	add, update, remove := diffSecurityGroupRules(current, desired)
+
+	tflog.Debug(ctx, "vnpaycloud_security_group sync rules", map[string]interface{}{
+		"id":        id,
+		"direction": direction,
+		"add":       add,
+		"update":    update,
+		"remove":    remove,
+	})
+
+	for _, r := range update {
+		updateOpts := dto.UpdateSecurityGroupRuleRequest{
+			RemoteIPPrefix: r.RemoteIPPrefix,
+			Description:    r.Description,
+		}
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.SecurityGroupRuleWithID(cfg.ProjectID, r.ID), updateOpts, nil, nil); err != nil {
+			return fmt.Errorf("error updating rule %s: %s", r.ID, err)
+		}
+	}
+
+	for _, r := range add {
+		createOpts := dto.CreateSecurityGroupRuleRequest{
+			SecurityGroupID: id,
+			Direction:       r.Direction,
+			Protocol:        r.Protocol,
+			EtherType:       r.EtherType,
+			PortRangeMin:    r.PortRangeMin,
+			PortRangeMax:    r.PortRangeMax,
+			RemoteIPPrefix:  r.RemoteIPPrefix,
+			RemoteGroupID:   r.RemoteGroupID,
+			Description:     r.Description,
+		}
+		if _, err := cfg.Client.Post(ctx, client.ApiPath.SecurityGroupRules(cfg.ProjectID), createOpts, nil, nil); err != nil {
+			return fmt.Errorf("error creating rule %s: %s", securityGroupRuleKey(r), err)
+		}
+	}
+
+	for _, r := range remove {
+		if _, err := cfg.Client.Delete(ctx, client.ApiPath.SecurityGroupRuleWithID(cfg.ProjectID, r.ID), nil); err != nil {
+			if !util.ResponseCodeIs(err, http.StatusNotFound) {
+				return fmt.Errorf("error deleting rule %s: %s", r.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
 func setSecurityGroupLog(ctx context.Context, cfg *config.Config, id string, enable bool) error {
 	logOpts := dto.UpdateSecurityGroupLogRequest{EnableLog: enable}
 
@@ -218,6 +312,23 @@ func setSecurityGroupLog(ctx context.Context, cfg *config.Config, id string, ena
 func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
+	// Revoking the rules first drops references to other security groups,
+	// so groups that reference each other can be deleted.
+	if d.Get("revoke_rules_on_delete").(bool) {
+		sg, err := readSecurityGroup(ctx, cfg, d.Id())
+		if err != nil {
+			return diag.FromErr(util.CheckDeleted(d, err, "Error retrieving vnpaycloud_security_group"))
+		}
+		for _, r := range sg.Rules {
+			tflog.Debug(ctx, "vnpaycloud_security_group revoke rule", map[string]interface{}{"id": d.Id(), "rule_id": r.ID})
+			if _, err := cfg.Client.Delete(ctx, client.ApiPath.SecurityGroupRuleWithID(cfg.ProjectID, r.ID), nil); err != nil {
+				if !util.ResponseCodeIs(err, http.StatusNotFound) {
+					return diag.Errorf("Error revoking rule %s of vnpaycloud_security_group %s: %s", r.ID, d.Id(), err)
+				}
+			}
+		}
+	}
+
 	if _, err := cfg.Client.Delete(ctx, client.ApiPath.SecurityGroupWithID(cfg.ProjectID, d.Id()), nil); err != nil {
 		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vnpaycloud_security_group"))
 	}
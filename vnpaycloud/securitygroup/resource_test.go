@@ -4,12 +4,16 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"path"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -384,6 +388,17 @@ func TestResourceSecurityGroupUpdate(t *testing.T) {
 
 func TestResourceSecurityGroupRead(t *testing.T) {
 	sg := testSecurityGroup()
+	sg.Rules[0].Description = "allow http"
+	sg.Rules = append(sg.Rules, dto.SecurityGroupRule{
+		ID:              "sgr-002",
+		SecurityGroupID: "sg-001",
+		Direction:       "ingress",
+		Protocol:        "tcp",
+		EtherType:       "IPv4",
+		PortRangeMin:    5432,
+		PortRangeMax:    5432,
+		RemoteGroupID:   "sg-app-001",
+	})
 
 	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
 		{
@@ -417,8 +432,8 @@ func TestResourceSecurityGroupRead(t *testing.T) {
 	}
 
 	rules := d.Get("rules").([]interface{})
-	if len(rules) != 1 {
-		t.Fatalf("expected 1 rule, got %d", len(rules))
+	if len(rules) != 2 {
+		t.Fatalf("expected 2 rules, got %d", len(rules))
 	}
 	rule := rules[0].(map[string]interface{})
 	if rule["id"] != "sgr-001" {
@@ -436,6 +451,17 @@ func TestResourceSecurityGroupRead(t *testing.T) {
 	if rule["remote_ip_prefix"] != "0.0.0.0/0" {
 		t.Errorf("expected rule remote_ip_prefix 0.0.0.0/0, got %v", rule["remote_ip_prefix"])
 	}
+	if rule["description"] != "allow http" {
+		t.Errorf("expected rule description 'allow http', got %v", rule["description"])
+	}
+
+	groupRule := rules[1].(map[string]interface{})
+	if groupRule["remote_group_id"] != "sg-app-001" {
+		t.Errorf("expected rule remote_group_id sg-app-001, got %v", groupRule["remote_group_id"])
+	}
+	if groupRule["remote_ip_prefix"] != "" {
+		t.Errorf("expected empty rule remote_ip_prefix, got %v", groupRule["remote_ip_prefix"])
+	}
 }
 
 func TestResourceSecurityGroupRead_NotFound(t *testing.T) {
@@ -581,3 +607,261 @@ func TestResourceSecurityGroupDelete_Error(t *testing.T) {
 		t.Fatal("expected error for 500 response, got nil")
 	}
 }
+
+// testSecurityGroupWithConsoleRules returns a security group holding a
+// managed ingress rule, an ingress rule added by hand and the default egress
+// rule.
+func testSecurityGroupWithConsoleRules() dto.SecurityGroup {
+	sg := testSecurityGroup()
+	sg.Rules = []dto.SecurityGroupRule{
+		{ID: "sgr-http", SecurityGroupID: "sg-001", Direction: "ingress", Protocol: "tcp", EtherType: "IPv4", PortRangeMin: 80, PortRangeMax: 80, RemoteIPPrefix: "0.0.0.0/0", Description: "web"},
+		{ID: "sgr-console", SecurityGroupID: "sg-001", Direction: "ingress", Protocol: "tcp", EtherType: "IPv4", PortRangeMin: 22, PortRangeMax: 22, RemoteIPPrefix: "0.0.0.0/0"},
+		{ID: "sgr-egress", SecurityGroupID: "sg-001", Direction: "egress", EtherType: "IPv4", RemoteIPPrefix: "0.0.0.0/0"},
+	}
+	return sg
+}
+
+// TestResourceSecurityGroupUpdate_InlineRules verifies that configured
+// ingress blocks are applied authoritatively: new rules are added before
+// unmanaged ones are removed, descriptions are updated in place, and the
+// unconfigured egress direction is left alone.
+func TestResourceSecurityGroupUpdate_InlineRules(t *testing.T) {
+	sg := testSecurityGroupWithConsoleRules()
+
+	var mu sync.Mutex
+	var calls []string
+	var created dto.CreateSecurityGroupRuleRequest
+	var updated dto.UpdateSecurityGroupRuleRequest
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Pattern: "/v2/iac/projects/test-project-id/security-groups/sg-001",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPut:
+					w.WriteHeader(http.StatusOK)
+				case http.MethodGet:
+					testhelpers.JSONHandler(t, http.StatusOK, dto.SecurityGroupResponse{SecurityGroup: sg})(w, r)
+				default:
+					http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				}
+			},
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/security-group-rules",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				calls = append(calls, "POST")
+				mu.Unlock()
+				if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Pattern: "/v2/iac/projects/test-project-id/security-group-rules/",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				calls = append(calls, r.Method+" "+path.Base(r.URL.Path))
+				mu.Unlock()
+				if r.Method == http.MethodPut {
+					if err := json.NewDecoder(r.Body).Decode(&updated); err != nil {
+						t.Errorf("failed to decode request body: %v", err)
+					}
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSecurityGroup()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":        "test-sg",
+		"description": "a test security group",
+		"ingress": []interface{}{
+			map[string]interface{}{"protocol": "tcp", "port_range_min": 80, "port_range_max": 80, "remote_ip_prefix": "0.0.0.0/0", "description": "http"},
+			map[string]interface{}{"protocol": "tcp", "port_range_min": 5432, "port_range_max": 5432, "remote_group_id": "sg-app-001"},
+		},
+	})
+	d.SetId("sg-001")
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := strings.Join(calls, ","); got != "PUT sgr-http,POST,DELETE sgr-console" {
+		t.Errorf("expected PUT sgr-http, POST, DELETE sgr-console in order, got %s", got)
+	}
+	if updated.Description != "http" {
+		t.Errorf("expected description http to be sent, got %q", updated.Description)
+	}
+	if created.Direction != "ingress" || created.RemoteGroupID != "sg-app-001" || created.PortRangeMin != 5432 || created.EtherType != "IPv4" {
+		t.Errorf("unexpected create request %+v", created)
+	}
+	if n := d.Get("egress").(*schema.Set).Len(); n != 1 {
+		t.Errorf("expected the default egress rule to be read into egress, got %d rules", n)
+	}
+}
+
+// TestResourceSecurityGroupRead_InlineRules verifies that ingress and egress
+// hold every rule of the group, including ones added outside Terraform, so
+// they show up as drift when the blocks are configured.
+func TestResourceSecurityGroupRead_InlineRules(t *testing.T) {
+	sg := testSecurityGroupWithConsoleRules()
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/security-groups/sg-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SecurityGroupResponse{SecurityGroup: sg}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSecurityGroup()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "test-sg",
+	})
+	d.SetId("sg-001")
+
+	diags := res.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	ingress := d.Get("ingress").(*schema.Set).List()
+	if len(ingress) != 2 {
+		t.Fatalf("expected 2 ingress rules, got %d", len(ingress))
+	}
+	ports := map[int]bool{}
+	for _, r := range ingress {
+		ports[r.(map[string]interface{})["port_range_min"].(int)] = true
+	}
+	if !ports[80] || !ports[22] {
+		t.Errorf("expected ingress rules for ports 80 and 22, got %v", ports)
+	}
+	if n := d.Get("egress").(*schema.Set).Len(); n != 1 {
+		t.Errorf("expected 1 egress rule, got %d", n)
+	}
+}
+
+// TestResourceSecurityGroupDelete_RevokeRules verifies that every rule is
+// revoked before the group is deleted when revoke_rules_on_delete is set.
+func TestResourceSecurityGroupDelete_RevokeRules(t *testing.T) {
+	sg := testSecurityGroupWithConsoleRules()
+
+	var mu sync.Mutex
+	var calls []string
+	var getCalls int32
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Pattern: "/v2/iac/projects/test-project-id/security-groups/sg-001",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodDelete:
+					mu.Lock()
+					calls = append(calls, "DELETE sg-001")
+					mu.Unlock()
+					w.WriteHeader(http.StatusNoContent)
+				case http.MethodGet:
+					if atomic.AddInt32(&getCalls, 1) == 1 {
+						testhelpers.JSONHandler(t, http.StatusOK, dto.SecurityGroupResponse{SecurityGroup: sg})(w, r)
+					} else {
+						w.WriteHeader(http.StatusNotFound)
+					}
+				default:
+					http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				}
+			},
+		},
+		{
+			Method:  "DELETE",
+			Pattern: "/v2/iac/projects/test-project-id/security-group-rules/",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				calls = append(calls, "DELETE "+path.Base(r.URL.Path))
+				mu.Unlock()
+				w.WriteHeader(http.StatusNoContent)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSecurityGroup()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":                   "test-sg",
+		"revoke_rules_on_delete": true,
+	})
+	d.SetId("sg-001")
+
+	diags := res.DeleteContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	want := "DELETE sgr-http,DELETE sgr-console,DELETE sgr-egress,DELETE sg-001"
+	if got := strings.Join(calls, ","); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestDiffSecurityGroupRules(t *testing.T) {
+	current := testSecurityGroupWithConsoleRules().Rules[:2]
+	desired := []dto.SecurityGroupRule{
+		{Direction: "ingress", Protocol: "tcp", EtherType: "IPv4", PortRangeMin: 80, PortRangeMax: 80, RemoteIPPrefix: "0.0.0.0/0"},
+		{Direction: "ingress", Protocol: "tcp", EtherType: "IPv4", PortRangeMin: 443, PortRangeMax: 443, RemoteIPPrefix: "0.0.0.0/0"},
+	}
+
+	add, update, remove := diffSecurityGroupRules(current, desired)
+
+	if len(add) != 1 || add[0].PortRangeMin != 443 {
+		t.Errorf("expected only the 443 rule to be added, got %+v", add)
+	}
+	if len(update) != 1 || update[0].ID != "sgr-http" || update[0].Description != "" {
+		t.Errorf("expected sgr-http to have its description cleared, got %+v", update)
+	}
+	if len(remove) != 1 || remove[0].ID != "sgr-console" {
+		t.Errorf("expected only sgr-console to be removed, got %+v", remove)
+	}
+}
+
+func TestValidateSecurityGroupInlineRules(t *testing.T) {
+	block := func(prefix, group cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"remote_ip_prefix": prefix, "remote_group_id": group})
+	}
+	blockType := block(cty.NullVal(cty.String), cty.NullVal(cty.String)).Type()
+	config := func(ingress ...cty.Value) cty.Value {
+		set := cty.SetValEmpty(blockType)
+		if len(ingress) > 0 {
+			set = cty.SetVal(ingress)
+		}
+		return cty.ObjectVal(map[string]cty.Value{"ingress": set, "egress": cty.SetValEmpty(blockType)})
+	}
+
+	cases := []struct {
+		name    string
+		raw     cty.Value
+		wantErr bool
+	}{
+		{"null config", cty.NullVal(config().Type()), false},
+		{"no blocks", config(), false},
+		{"prefix", config(block(cty.StringVal("10.0.0.0/8"), cty.NullVal(cty.String))), false},
+		{"unknown group", config(block(cty.NullVal(cty.String), cty.UnknownVal(cty.String))), false},
+		{"neither", config(block(cty.NullVal(cty.String), cty.NullVal(cty.String))), true},
+		{"both", config(block(cty.StringVal("10.0.0.0/8"), cty.StringVal("sg-app-001"))), true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSecurityGroupInlineRules(tc.raw)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("expected error %v, got %v", tc.wantErr, err)
+			}
+		})
+	}
+}
@@ -54,9 +54,16 @@ func ResourceSecurityGroupRule() *schema.Resource {
 				ForceNew: true,
 			},
 			"remote_ip_prefix": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"remote_group_id"},
+			},
+			"remote_group_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"remote_ip_prefix"},
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -77,6 +84,7 @@ func resourceSecurityGroupRuleCreate(ctx context.Context, d *schema.ResourceData
 		PortRangeMin:    int32(d.Get("port_range_min").(int)),
 		PortRangeMax:    int32(d.Get("port_range_max").(int)),
 		RemoteIPPrefix:  d.Get("remote_ip_prefix").(string),
+		RemoteGroupID:   d.Get("remote_group_id").(string),
 		Description:     d.Get("description").(string),
 	}
 
@@ -111,6 +119,7 @@ func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("port_range_min", ruleResp.Rule.PortRangeMin)
 	d.Set("port_range_max", ruleResp.Rule.PortRangeMax)
 	d.Set("remote_ip_prefix", ruleResp.Rule.RemoteIPPrefix)
+	d.Set("remote_group_id", ruleResp.Rule.RemoteGroupID)
 	d.Set("description", ruleResp.Rule.Description)
 
 	return nil
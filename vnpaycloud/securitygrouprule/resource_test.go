@@ -2,6 +2,7 @@ package securitygrouprule
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"sync/atomic"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/securitygroup"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // testSecurityGroupRule returns a fully populated dto.SecurityGroupRule for use in tests.
@@ -117,6 +119,82 @@ func TestResourceSecurityGroupRuleCreate_Error(t *testing.T) {
 	}
 }
 
+// TestResourceSecurityGroupRuleCreate_RemoteGroup verifies that a rule
+// referencing another security group sends remote_group_id and reads it back.
+func TestResourceSecurityGroupRuleCreate_RemoteGroup(t *testing.T) {
+	rule := testSecurityGroupRule()
+	rule.RemoteIPPrefix = ""
+	rule.RemoteGroupID = "sg-app-001"
+	rule.Description = "from app tier"
+
+	var gotOpts dto.CreateSecurityGroupRuleRequest
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/security-group-rules",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&gotOpts); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.SecurityGroupRuleResponse{Rule: rule})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/security-group-rules/sgr-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SecurityGroupRuleResponse{Rule: rule}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSecurityGroupRule()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"security_group_id": "sg-001",
+		"direction":         "ingress",
+		"protocol":          "tcp",
+		"port_range_min":    443,
+		"port_range_max":    443,
+		"remote_group_id":   "sg-app-001",
+		"description":       "from app tier",
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if gotOpts.RemoteGroupID != "sg-app-001" {
+		t.Errorf("expected request remoteGroupId sg-app-001, got %q", gotOpts.RemoteGroupID)
+	}
+	if gotOpts.RemoteIPPrefix != "" {
+		t.Errorf("expected no request remoteIpPrefix, got %q", gotOpts.RemoteIPPrefix)
+	}
+	if v := d.Get("remote_group_id").(string); v != "sg-app-001" {
+		t.Errorf("expected remote_group_id sg-app-001, got %s", v)
+	}
+	if v := d.Get("remote_ip_prefix").(string); v != "" {
+		t.Errorf("expected empty remote_ip_prefix, got %s", v)
+	}
+	if v := d.Get("description").(string); v != "from app tier" {
+		t.Errorf("expected description 'from app tier', got %s", v)
+	}
+}
+
+// TestResourceSecurityGroupRule_RemoteConflict verifies that remote_ip_prefix
+// and remote_group_id cannot both be set.
+func TestResourceSecurityGroupRule_RemoteConflict(t *testing.T) {
+	res := ResourceSecurityGroupRule()
+	diags := res.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"security_group_id": "sg-001",
+		"direction":         "ingress",
+		"remote_ip_prefix":  "10.0.0.0/8",
+		"remote_group_id":   "sg-app-001",
+	}))
+	if !diags.HasError() {
+		t.Fatal("expected conflict error when both remote_ip_prefix and remote_group_id are set")
+	}
+}
+
 func TestResourceSecurityGroupRuleRead(t *testing.T) {
 	rule := testSecurityGroupRule()
 
@@ -169,6 +247,48 @@ func TestResourceSecurityGroupRuleRead(t *testing.T) {
 	}
 }
 
+// TestResourceSecurityGroupRuleImport_RemoteGroup verifies that importing a
+// rule that references a security group populates remote_group_id.
+func TestResourceSecurityGroupRuleImport_RemoteGroup(t *testing.T) {
+	rule := testSecurityGroupRule()
+	rule.RemoteIPPrefix = ""
+	rule.RemoteGroupID = "sg-app-001"
+	rule.Description = "from app tier"
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/security-group-rules/sgr-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SecurityGroupRuleResponse{Rule: rule}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSecurityGroupRule()
+	d := res.TestResourceData()
+	d.SetId("sgr-001")
+
+	imported, err := res.Importer.StateContext(context.Background(), d, cfg)
+	if err != nil {
+		t.Fatalf("unexpected import error: %v", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("expected 1 imported resource, got %d", len(imported))
+	}
+
+	diags := res.ReadContext(context.Background(), imported[0], cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if v := imported[0].Get("remote_group_id").(string); v != "sg-app-001" {
+		t.Errorf("expected remote_group_id sg-app-001, got %s", v)
+	}
+	if v := imported[0].Get("description").(string); v != "from app tier" {
+		t.Errorf("expected description 'from app tier', got %s", v)
+	}
+}
+
 func TestResourceSecurityGroupRuleRead_NotFound(t *testing.T) {
 	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
 		{
@@ -31,3 +31,30 @@ func serverGroupStateRefreshFunc(ctx context.Context, c *client.Client, projectI
 		return sgResp.ServerGroup, "active", nil
 	}
 }
+
+// serverGroupPolicyNames returns the policies supported by the backend.
+func serverGroupPolicyNames(ctx context.Context, c *client.Client, projectID string) ([]string, error) {
+	policies, err := client.ListAll(ctx, c, client.ApiPath.ServerGroupPolicies(projectID), func(r *dto.ListServerGroupPoliciesResponse) []dto.ServerGroupPolicy { return r.Policies })
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(policies))
+	for i, p := range policies {
+		names[i] = p.Name
+	}
+	return names, nil
+}
+
+// flattenServerGroupHostDistribution counts the members placed on each host.
+func flattenServerGroupHostDistribution(members []dto.ServerGroupMember) map[string]interface{} {
+	distribution := make(map[string]interface{})
+	for _, m := range members {
+		if m.HostID == "" {
+			continue
+		}
+		count, _ := distribution[m.HostID].(int)
+		distribution[m.HostID] = count + 1
+	}
+	return distribution
+}
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,11 +31,20 @@ func DataSourceServerGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"max_server_per_host": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"member_ids": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"host_distribution": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -56,15 +66,14 @@ func dataSourceServerGroupRead(ctx context.Context, d *schema.ResourceData, meta
 		return nil
 	}
 
-	listResp := &dto.ListServerGroupsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.ServerGroups(cfg.ProjectID), listResp, nil)
+	serverGroups, err := client.ListAll(ctx, cfg.Client, client.ApiPath.ServerGroups(cfg.ProjectID), func(r *dto.ListServerGroupsResponse) []dto.ServerGroup { return r.ServerGroups })
 	if err != nil {
 		return diag.Errorf("Unable to query vnpaycloud_server_group: %s", err)
 	}
 
 	name := d.Get("name").(string)
 	var matched []dto.ServerGroup
-	for _, sg := range listResp.ServerGroups {
+	for _, sg := range serverGroups {
 		if name != "" && sg.Name != name {
 			continue
 		}
@@ -89,7 +98,9 @@ func setServerGroupDataSourceAttributes(d *schema.ResourceData, sg dto.ServerGro
 	d.SetId(sg.ID)
 	d.Set("name", sg.Name)
 	d.Set("policy", sg.Policy)
+	d.Set("max_server_per_host", sg.Rules.MaxServerPerHost)
 	d.Set("member_ids", sg.MemberIDs)
+	d.Set("host_distribution", flattenServerGroupHostDistribution(sg.Members))
 	d.Set("created_at", sg.CreatedAt)
 }
 
@@ -97,19 +108,27 @@ func DataSourceServerGroups() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceServerGroupsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"server_groups": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"id":     {Type: schema.TypeString, Computed: true},
-						"name":   {Type: schema.TypeString, Computed: true},
-						"policy": {Type: schema.TypeString, Computed: true},
+						"id":                  {Type: schema.TypeString, Computed: true},
+						"name":                {Type: schema.TypeString, Computed: true},
+						"policy":              {Type: schema.TypeString, Computed: true},
+						"max_server_per_host": {Type: schema.TypeInt, Computed: true},
 						"member_ids": {
 							Type:     schema.TypeList,
 							Computed: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"host_distribution": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
 						"created_at": {Type: schema.TypeString, Computed: true},
 					},
 				},
@@ -121,20 +140,26 @@ func DataSourceServerGroups() *schema.Resource {
 func dataSourceServerGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListServerGroupsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.ServerGroups(cfg.ProjectID), listResp, nil)
+	allServerGroups, err := client.ListAll(ctx, cfg.Client, client.ApiPath.ServerGroups(cfg.ProjectID), func(r *dto.ListServerGroupsResponse) []dto.ServerGroup { return r.ServerGroups })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_server_groups: %s", err)
 	}
 
+	allServerGroups, err = util.ApplyDataSourceFilters(d, allServerGroups, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_server_groups: %s", err)
+	}
+
 	var serverGroups []map[string]interface{}
-	for _, sg := range listResp.ServerGroups {
+	for _, sg := range allServerGroups {
 		serverGroups = append(serverGroups, map[string]interface{}{
-			"id":         sg.ID,
-			"name":       sg.Name,
-			"policy":     sg.Policy,
-			"member_ids": sg.MemberIDs,
-			"created_at": sg.CreatedAt,
+			"id":                  sg.ID,
+			"name":                sg.Name,
+			"policy":              sg.Policy,
+			"max_server_per_host": sg.Rules.MaxServerPerHost,
+			"member_ids":          sg.MemberIDs,
+			"host_distribution":   flattenServerGroupHostDistribution(sg.Members),
+			"created_at":          sg.CreatedAt,
 		})
 	}
 
@@ -2,6 +2,8 @@ package servergroup
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
@@ -12,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceServerGroup() *schema.Resource {
@@ -19,6 +22,7 @@ func ResourceServerGroup() *schema.Resource {
 		CreateContext: resourceServerGroupCreate,
 		ReadContext:   resourceServerGroupRead,
 		DeleteContext: resourceServerGroupDelete,
+		CustomizeDiff: resourceServerGroupCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -37,11 +41,22 @@ func ResourceServerGroup() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"max_server_per_host": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"member_ids": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"host_distribution": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+			},
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -58,6 +73,10 @@ func resourceServerGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 		Policy: d.Get("policy").(string),
 	}
 
+	if v, ok := d.GetOk("max_server_per_host"); ok {
+		createOpts.Rules = &dto.ServerGroupRules{MaxServerPerHost: int32(v.(int))}
+	}
+
 	tflog.Debug(ctx, "vnpaycloud_server_group create options", map[string]interface{}{"create_opts": createOpts})
 
 	createResp := &dto.ServerGroupResponse{}
@@ -98,12 +117,44 @@ func resourceServerGroupRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.Set("name", sgResp.ServerGroup.Name)
 	d.Set("policy", sgResp.ServerGroup.Policy)
+	d.Set("max_server_per_host", sgResp.ServerGroup.Rules.MaxServerPerHost)
 	d.Set("member_ids", sgResp.ServerGroup.MemberIDs)
+	d.Set("host_distribution", flattenServerGroupHostDistribution(sgResp.ServerGroup.Members))
 	d.Set("created_at", sgResp.ServerGroup.CreatedAt)
 
 	return nil
 }
 
+func resourceServerGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("policy") {
+		return nil
+	}
+
+	policy := d.Get("policy").(string)
+
+	// max_server_per_host is only honoured by the hard anti-affinity filter.
+	if v, ok := d.GetOk("max_server_per_host"); ok && policy != "anti-affinity" {
+		return fmt.Errorf("max_server_per_host (%d) can only be set with policy \"anti-affinity\", got %q", v.(int), policy)
+	}
+
+	if d.Id() != "" && !d.HasChange("policy") {
+		return nil
+	}
+
+	cfg := meta.(*config.Config)
+	policies, err := serverGroupPolicyNames(ctx, cfg.Client, cfg.ProjectID)
+	if err != nil {
+		return fmt.Errorf("error listing server group policies: %s", err)
+	}
+
+	for _, p := range policies {
+		if p == policy {
+			return nil
+		}
+	}
+	return fmt.Errorf("policy %q is not supported, must be one of: %s", policy, strings.Join(policies, ", "))
+}
+
 func resourceServerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
@@ -2,7 +2,9 @@ package servergroup
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"strings"
 	"sync/atomic"
 	"testing"
 
@@ -11,6 +13,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestResourceServerGroupCreate(t *testing.T) {
@@ -222,3 +225,152 @@ func TestResourceServerGroupDelete(t *testing.T) {
 		t.Fatalf("unexpected error: %v", diags)
 	}
 }
+
+func TestResourceServerGroupCreate_MaxServerPerHost(t *testing.T) {
+	sg := dto.ServerGroup{
+		ID:        "sg-123",
+		Name:      "my-server-group",
+		Policy:    "anti-affinity",
+		Rules:     dto.ServerGroupRules{MaxServerPerHost: 2},
+		CreatedAt: "2025-01-15T10:00:00Z",
+		ProjectID: testhelpers.TestProjectID,
+	}
+
+	var createBody dto.CreateServerGroupRequest
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: client.ApiPath.ServerGroups(testhelpers.TestProjectID),
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&createBody); err != nil {
+					t.Errorf("failed to decode request body: %v", err)
+				}
+				testhelpers.JSONHandler(t, http.StatusCreated, dto.ServerGroupResponse{ServerGroup: sg})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ServerGroupWithID(testhelpers.TestProjectID, "sg-123"),
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ServerGroupResponse{ServerGroup: sg}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceServerGroup()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":                "my-server-group",
+		"policy":              "anti-affinity",
+		"max_server_per_host": 2,
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if createBody.Rules == nil || createBody.Rules.MaxServerPerHost != 2 {
+		t.Errorf("expected rules.maxServerPerHost 2 in create request, got %+v", createBody.Rules)
+	}
+	if got := d.Get("max_server_per_host").(int); got != 2 {
+		t.Errorf("expected max_server_per_host 2, got %d", got)
+	}
+}
+
+func TestResourceServerGroupRead_HostDistribution(t *testing.T) {
+	sg := dto.ServerGroup{
+		ID:        "sg-123",
+		Name:      "my-server-group",
+		Policy:    "soft-anti-affinity",
+		MemberIDs: []string{"inst-1", "inst-2", "inst-3"},
+		Members: []dto.ServerGroupMember{
+			{ID: "inst-1", HostID: "host-a"},
+			{ID: "inst-2", HostID: "host-b"},
+			{ID: "inst-3", HostID: "host-a"},
+		},
+		ProjectID: testhelpers.TestProjectID,
+	}
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ServerGroupWithID(testhelpers.TestProjectID, "sg-123"),
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ServerGroupResponse{ServerGroup: sg}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceServerGroup()
+	d := res.TestResourceData()
+	d.SetId("sg-123")
+
+	diags := res.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	distribution := d.Get("host_distribution").(map[string]interface{})
+	if len(distribution) != 2 {
+		t.Fatalf("expected 2 hosts in host_distribution, got %v", distribution)
+	}
+	if distribution["host-a"] != 2 || distribution["host-b"] != 1 {
+		t.Errorf("expected host-a=2 and host-b=1, got %v", distribution)
+	}
+}
+
+func TestResourceServerGroupCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr string
+	}{
+		{
+			name: "soft anti-affinity",
+			raw:  map[string]interface{}{"name": "sg", "policy": "soft-anti-affinity"},
+		},
+		{
+			name: "anti-affinity with max_server_per_host",
+			raw:  map[string]interface{}{"name": "sg", "policy": "anti-affinity", "max_server_per_host": 2},
+		},
+		{
+			name:    "unsupported policy",
+			raw:     map[string]interface{}{"name": "sg", "policy": "spread"},
+			wantErr: `policy "spread" is not supported, must be one of: affinity, anti-affinity, soft-affinity, soft-anti-affinity`,
+		},
+		{
+			name:    "max_server_per_host with soft policy",
+			raw:     map[string]interface{}{"name": "sg", "policy": "soft-anti-affinity", "max_server_per_host": 2},
+			wantErr: `max_server_per_host (2) can only be set with policy "anti-affinity"`,
+		},
+	}
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: client.ApiPath.ServerGroupPolicies(testhelpers.TestProjectID),
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListServerGroupPoliciesResponse{Policies: []dto.ServerGroupPolicy{
+				{Name: "affinity"},
+				{Name: "anti-affinity"},
+				{Name: "soft-affinity"},
+				{Name: "soft-anti-affinity"},
+			}}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := ResourceServerGroup()
+			_, err := res.Diff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(tt.raw), cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -86,6 +87,8 @@ func DataSourceServiceEndpoints() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceServiceEndpointsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"service_gateway_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -107,13 +110,18 @@ func dataSourceServiceEndpointsRead(ctx context.Context, d *schema.ResourceData,
 		path += "?serviceGatewayId=" + sgID
 	}
 
-	resp := &dto.ListServiceEndpointsResponse{}
-	if _, err := cfg.Client.Get(ctx, path, resp, nil); err != nil {
+	serviceEndpoints, err := client.ListAll(ctx, cfg.Client, path, func(r *dto.ListServiceEndpointsResponse) []dto.ServiceEndpoint { return r.ServiceEndpoints })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_service_endpoints: %s", err)
 	}
 
-	endpoints := make([]map[string]interface{}, 0, len(resp.ServiceEndpoints))
-	for _, se := range resp.ServiceEndpoints {
+	serviceEndpoints, err = util.ApplyDataSourceFilters(d, serviceEndpoints, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_service_endpoints: %s", err)
+	}
+
+	endpoints := make([]map[string]interface{}, 0, len(serviceEndpoints))
+	for _, se := range serviceEndpoints {
 		endpoints = append(endpoints, flattenServiceEndpoint(se))
 	}
 
@@ -127,6 +135,8 @@ func DataSourceServiceProviders() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceServiceProvidersRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"providers": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -145,13 +155,18 @@ func DataSourceServiceProviders() *schema.Resource {
 func dataSourceServiceProvidersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListServiceProvidersResponse{}
-	if _, err := cfg.Client.Get(ctx, client.ApiPath.ServiceProviders(cfg.ProjectID), resp, nil); err != nil {
+	allProviders, err := client.ListAll(ctx, cfg.Client, client.ApiPath.ServiceProviders(cfg.ProjectID), func(r *dto.ListServiceProvidersResponse) []dto.ServiceProvider { return r.Providers })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_service_providers: %s", err)
 	}
 
-	providers := make([]map[string]interface{}, 0, len(resp.Providers))
-	for _, p := range resp.Providers {
+	allProviders, err = util.ApplyDataSourceFilters(d, allProviders, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_service_providers: %s", err)
+	}
+
+	providers := make([]map[string]interface{}, 0, len(allProviders))
+	for _, p := range allProviders {
 		providers = append(providers, map[string]interface{}{
 			"id":     p.ID,
 			"name":   p.Name,
@@ -169,6 +184,8 @@ func DataSourceServices() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceServicesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"provider_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -208,13 +225,18 @@ func dataSourceServicesRead(ctx context.Context, d *schema.ResourceData, meta in
 		path += sep + "name=" + name
 	}
 
-	resp := &dto.ListServicesResponse{}
-	if _, err := cfg.Client.Get(ctx, path, resp, nil); err != nil {
+	allServices, err := client.ListAll(ctx, cfg.Client, path, func(r *dto.ListServicesResponse) []dto.Service { return r.Services })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_services: %s", err)
 	}
 
-	services := make([]map[string]interface{}, 0, len(resp.Services))
-	for _, s := range resp.Services {
+	allServices, err = util.ApplyDataSourceFilters(d, allServices, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_services: %s", err)
+	}
+
+	services := make([]map[string]interface{}, 0, len(allServices))
+	for _, s := range allServices {
 		services = append(services, map[string]interface{}{
 			"id":             s.ID,
 			"name":           s.Name,
@@ -5,6 +5,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -82,6 +83,8 @@ func DataSourceServiceGateways() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceServiceGatewaysRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"service_gateways": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -94,13 +97,18 @@ func DataSourceServiceGateways() *schema.Resource {
 func dataSourceServiceGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListServiceGatewaysResponse{}
-	if _, err := cfg.Client.Get(ctx, client.ApiPath.ServiceGateways(cfg.ProjectID), resp, nil); err != nil {
+	serviceGateways, err := client.ListAll(ctx, cfg.Client, client.ApiPath.ServiceGateways(cfg.ProjectID), func(r *dto.ListServiceGatewaysResponse) []dto.ServiceGateway { return r.ServiceGateways })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_service_gateways: %s", err)
 	}
 
-	gateways := make([]map[string]interface{}, 0, len(resp.ServiceGateways))
-	for _, sg := range resp.ServiceGateways {
+	serviceGateways, err = util.ApplyDataSourceFilters(d, serviceGateways, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_service_gateways: %s", err)
+	}
+
+	gateways := make([]map[string]interface{}, 0, len(serviceGateways))
+	for _, sg := range serviceGateways {
 		gateways = append(gateways, flattenServiceGateway(sg))
 	}
 
@@ -114,6 +122,8 @@ func DataSourceServiceGatewayFlavors() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceServiceGatewayFlavorsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"flavors": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -132,13 +142,18 @@ func DataSourceServiceGatewayFlavors() *schema.Resource {
 func dataSourceServiceGatewayFlavorsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	resp := &dto.ListServiceGatewayFlavorsResponse{}
-	if _, err := cfg.Client.Get(ctx, client.ApiPath.ServiceGatewayFlavors(cfg.ProjectID), resp, nil); err != nil {
+	allFlavors, err := client.ListAll(ctx, cfg.Client, client.ApiPath.ServiceGatewayFlavors(cfg.ProjectID), func(r *dto.ListServiceGatewayFlavorsResponse) []dto.ServiceGatewayFlavor { return r.Flavors })
+	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_service_gateway_flavors: %s", err)
 	}
 
-	flavors := make([]map[string]interface{}, 0, len(resp.Flavors))
-	for _, f := range resp.Flavors {
+	allFlavors, err = util.ApplyDataSourceFilters(d, allFlavors, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_service_gateway_flavors: %s", err)
+	}
+
+	flavors := make([]map[string]interface{}, 0, len(allFlavors))
+	for _, f := range allFlavors {
 		flavors = append(flavors, map[string]interface{}{
 			"id":          f.ID,
 			"name":        f.Name,
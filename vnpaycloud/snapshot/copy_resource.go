@@ -0,0 +1,228 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceSnapshotCopy copies a snapshot of the provider project into another
+// zone or project. The copy lives in the destination project.
+func ResourceSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSnapshotCopyCreate,
+		ReadContext:   resourceSnapshotCopyRead,
+		DeleteContext: resourceSnapshotCopyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSnapshotCopyImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"source_snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"destination_zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"destination_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	sourceID := d.Get("source_snapshot_id").(string)
+
+	// The backend only copies snapshots that have finished creating.
+	sourceConf := &retry.StateChangeConf{
+		Pending:    []string{"initiating", "creating"},
+		Target:     []string{"active", "created", "available"},
+		Refresh:    snapshotStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, sourceID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := sourceConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for source vnpaycloud_snapshot %s to become ready: %s", sourceID, err)
+	}
+
+	copyOpts := dto.CopySnapshotRequest{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		DestinationZoneID:    d.Get("destination_zone_id").(string),
+		DestinationProjectID: d.Get("destination_project_id").(string),
+	}
+
+	tflog.Debug(ctx, "vnpaycloud_snapshot_copy create options", map[string]interface{}{
+		"source_snapshot_id": sourceID,
+		"copy_opts":          copyOpts,
+	})
+
+	copyResp := &dto.SnapshotResponse{}
+	if _, err := cfg.Client.Post(ctx, client.ApiPath.SnapshotCopy(cfg.ProjectID, sourceID), copyOpts, copyResp, nil); err != nil {
+		return diag.Errorf("Error copying vnpaycloud_snapshot %s: %s", sourceID, err)
+	}
+
+	// A copy into another zone may land in that zone's project even when
+	// destination_project_id is unset, so track the project the API reports.
+	destProjectID := copyResp.Snapshot.ProjectID
+	if destProjectID == "" {
+		destProjectID = snapshotCopyProjectID(d, cfg)
+	}
+
+	d.SetId(copyResp.Snapshot.ID)
+	d.Set("destination_project_id", destProjectID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"initiating", "creating", "copying"},
+		Target:     []string{"active", "created", "available"},
+		Refresh:    snapshotStateRefreshFunc(ctx, cfg.Client, destProjectID, copyResp.Snapshot.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for vnpaycloud_snapshot_copy %s to become ready: %s", copyResp.Snapshot.ID, err)
+	}
+
+	return resourceSnapshotCopyRead(ctx, d, meta)
+}
+
+func resourceSnapshotCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	destProjectID := snapshotCopyProjectID(d, cfg)
+
+	snapResp := &dto.SnapshotResponse{}
+	_, err := cfg.Client.Get(ctx, client.ApiPath.SnapshotWithID(destProjectID, d.Id()), snapResp, nil)
+	if err != nil {
+		return diag.FromErr(util.CheckNotFound(d, err, "Error retrieving vnpaycloud_snapshot_copy"))
+	}
+
+	tflog.Debug(ctx, "Retrieved vnpaycloud_snapshot_copy "+d.Id(), map[string]interface{}{"snapshot": snapResp.Snapshot})
+
+	if snapResp.Snapshot.SourceSnapshotID != "" {
+		d.Set("source_snapshot_id", snapResp.Snapshot.SourceSnapshotID)
+	}
+	d.Set("name", snapResp.Snapshot.Name)
+	d.Set("description", snapResp.Snapshot.Description)
+	d.Set("destination_zone_id", snapResp.Snapshot.ZoneID)
+	d.Set("destination_project_id", destProjectID)
+	d.Set("volume_id", snapResp.Snapshot.VolumeID)
+	d.Set("size", snapResp.Snapshot.SizeGB)
+	d.Set("status", snapResp.Snapshot.Status)
+	d.Set("created_at", snapResp.Snapshot.CreatedAt)
+
+	return nil
+}
+
+func resourceSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	destProjectID := snapshotCopyProjectID(d, cfg)
+
+	snapResp := &dto.SnapshotResponse{}
+	_, err := cfg.Client.Get(ctx, client.ApiPath.SnapshotWithID(destProjectID, d.Id()), snapResp, nil)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error retrieving vnpaycloud_snapshot_copy"))
+	}
+
+	if snapResp.Snapshot.Status != "deleting" {
+		if _, err := cfg.Client.Delete(ctx, client.ApiPath.SnapshotWithID(destProjectID, d.Id()), nil); err != nil {
+			return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vnpaycloud_snapshot_copy"))
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"deleting", "active", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    snapshotStateRefreshFunc(ctx, cfg.Client, destProjectID, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for vnpaycloud_snapshot_copy %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// resourceSnapshotCopyImport accepts either <snapshot_id> for copies in the
+// provider project or <destination_project_id>/<snapshot_id>.
+func resourceSnapshotCopyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	switch len(parts) {
+	case 1:
+	case 2:
+		if parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid vnpaycloud_snapshot_copy import ID %q, expected <destination_project_id>/<snapshot_id>", d.Id())
+		}
+		d.Set("destination_project_id", parts[0])
+		d.SetId(parts[1])
+	default:
+		return nil, fmt.Errorf("invalid vnpaycloud_snapshot_copy import ID %q, expected <snapshot_id> or <destination_project_id>/<snapshot_id>", d.Id())
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// snapshotCopyProjectID returns the project the copy lives in.
+func snapshotCopyProjectID(d *schema.ResourceData, cfg *config.Config) string {
+	if projectID := d.Get("destination_project_id").(string); projectID != "" {
+		return projectID
+	}
+	return cfg.ProjectID
+}
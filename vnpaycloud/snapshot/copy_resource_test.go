@@ -0,0 +1,184 @@
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceSnapshotCopyCreate(t *testing.T) {
+	source := dto.Snapshot{ID: "snap-001", Name: "source", VolumeID: "vol-001", SizeGB: 50, Status: "available"}
+	copied := dto.Snapshot{
+		ID:               "snap-copy",
+		Name:             "dr-copy",
+		VolumeID:         "vol-001",
+		SourceSnapshotID: "snap-001",
+		SizeGB:           50,
+		Status:           "available",
+		CreatedAt:        "2025-01-15T10:00:00Z",
+		ProjectID:        "dr-project-id",
+		ZoneID:           "dr-zone-id",
+	}
+
+	var copyBody dto.CopySnapshotRequest
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/snapshots/snap-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotResponse{Snapshot: source}),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/snapshots/snap-001/copy",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&copyBody); err != nil {
+					t.Errorf("failed to decode copy body: %v", err)
+				}
+				pending := copied
+				pending.Status = "copying"
+				testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotResponse{Snapshot: pending})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/dr-project-id/snapshots/snap-copy",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotResponse{Snapshot: copied}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSnapshotCopy()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"source_snapshot_id":     "snap-001",
+		"name":                   "dr-copy",
+		"destination_zone_id":    "dr-zone-id",
+		"destination_project_id": "dr-project-id",
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "snap-copy" {
+		t.Errorf("expected ID snap-copy, got %s", d.Id())
+	}
+	if copyBody.DestinationZoneID != "dr-zone-id" || copyBody.DestinationProjectID != "dr-project-id" {
+		t.Errorf("expected copy to dr-zone-id/dr-project-id, got %+v", copyBody)
+	}
+	if v := d.Get("status").(string); v != "available" {
+		t.Errorf("expected status available, got %s", v)
+	}
+	if v := d.Get("size").(int); v != 50 {
+		t.Errorf("expected size 50, got %d", v)
+	}
+}
+
+func TestResourceSnapshotCopyCreate_OtherZoneProject(t *testing.T) {
+	source := dto.Snapshot{ID: "snap-001", Name: "source", VolumeID: "vol-001", SizeGB: 50, Status: "available"}
+	copied := dto.Snapshot{
+		ID:               "snap-copy",
+		Name:             "dr-copy",
+		VolumeID:         "vol-001",
+		SourceSnapshotID: "snap-001",
+		SizeGB:           50,
+		Status:           "available",
+		ProjectID:        "dr-zone-project-id",
+		ZoneID:           "dr-zone-id",
+	}
+
+	var deleted bool
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/snapshots/snap-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotResponse{Snapshot: source}),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/snapshots/snap-001/copy",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotResponse{Snapshot: copied}),
+		},
+		{
+			Pattern: "/v2/iac/projects/dr-zone-project-id/snapshots/snap-copy",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodDelete:
+					deleted = true
+					w.WriteHeader(http.StatusNoContent)
+				case deleted:
+					w.WriteHeader(http.StatusNotFound)
+				default:
+					testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotResponse{Snapshot: copied})(w, r)
+				}
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSnapshotCopy()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"source_snapshot_id":  "snap-001",
+		"name":                "dr-copy",
+		"destination_zone_id": "dr-zone-id",
+	})
+
+	if diags := res.CreateContext(context.Background(), d, cfg); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if v := d.Get("destination_project_id").(string); v != "dr-zone-project-id" {
+		t.Errorf("expected destination_project_id dr-zone-project-id, got %s", v)
+	}
+
+	if diags := res.DeleteContext(context.Background(), d, cfg); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !deleted {
+		t.Error("expected the copy to be deleted in dr-zone-project-id")
+	}
+}
+
+func TestResourceSnapshotCopyImport(t *testing.T) {
+	tests := []struct {
+		id          string
+		wantID      string
+		wantProject string
+		wantErr     bool
+	}{
+		{id: "snap-copy", wantID: "snap-copy"},
+		{id: "dr-project-id/snap-copy", wantID: "snap-copy", wantProject: "dr-project-id"},
+		{id: "/snap-copy", wantErr: true},
+		{id: "a/b/c", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			res := ResourceSnapshotCopy()
+			d := res.TestResourceData()
+			d.SetId(tt.id)
+
+			_, err := resourceSnapshotCopyImport(context.Background(), d, nil)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d.Id() != tt.wantID {
+				t.Errorf("expected ID %s, got %s", tt.wantID, d.Id())
+			}
+			if v := d.Get("destination_project_id").(string); v != tt.wantProject {
+				t.Errorf("expected destination_project_id %q, got %q", tt.wantProject, v)
+			}
+		})
+	}
+}
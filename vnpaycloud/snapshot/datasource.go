@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -61,15 +62,14 @@ func dataSourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta in
 		return setSnapshotData(d, &snapResp.Snapshot)
 	}
 
-	listResp := &dto.ListSnapshotsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Snapshots(cfg.ProjectID), listResp, nil)
+	snapshots, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Snapshots(cfg.ProjectID), func(r *dto.ListSnapshotsResponse) []dto.Snapshot { return r.Snapshots })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_snapshot: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, snap := range listResp.Snapshots {
+	for _, snap := range snapshots {
 		if nameOk && snap.Name != nameFilter.(string) {
 			continue
 		}
@@ -94,6 +94,8 @@ func DataSourceSnapshots() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceSnapshotsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"snapshots": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -116,14 +118,18 @@ func DataSourceSnapshots() *schema.Resource {
 func dataSourceSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListSnapshotsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Snapshots(cfg.ProjectID), listResp, nil)
+	allSnapshots, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Snapshots(cfg.ProjectID), func(r *dto.ListSnapshotsResponse) []dto.Snapshot { return r.Snapshots })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_snapshots: %s", err)
 	}
 
+	allSnapshots, err = util.ApplyDataSourceFilters(d, allSnapshots, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_snapshots: %s", err)
+	}
+
 	var snapshots []map[string]interface{}
-	for _, snap := range listResp.Snapshots {
+	for _, snap := range allSnapshots {
 		snapshots = append(snapshots, map[string]interface{}{
 			"id":          snap.ID,
 			"name":        snap.Name,
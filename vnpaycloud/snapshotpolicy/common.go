@@ -0,0 +1,175 @@
+package snapshotpolicy
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cronFieldBounds lists the name and allowed range of each field of a
+// five-field cron expression, in order.
+var cronFieldBounds = []struct {
+	name     string
+	min, max int
+}{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 6},
+}
+
+// snapshotTemplateVars are the placeholders the backend expands in snapshot
+// name and description templates.
+var snapshotTemplateVars = []string{"volume_id", "volume_name", "policy_name", "date", "timestamp"}
+
+var snapshotTemplateVarRegexp = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// validateCronSchedule checks a five-field cron expression: minute, hour,
+// day of month, month and day of week. Each field is "*", a number or a range,
+// optionally with a "/step", or a comma separated list of those.
+func validateCronSchedule(v interface{}, k string) (ws []string, errs []error) {
+	fields := strings.Fields(v.(string))
+	if len(fields) != len(cronFieldBounds) {
+		errs = append(errs, fmt.Errorf("%q must be a cron expression with %d fields (minute hour day-of-month month day-of-week), got %q", k, len(cronFieldBounds), v))
+		return
+	}
+
+	for i, field := range fields {
+		bounds := cronFieldBounds[i]
+		for _, item := range strings.Split(field, ",") {
+			if err := validateCronItem(item, bounds.min, bounds.max); err != nil {
+				errs = append(errs, fmt.Errorf("%q has an invalid %s field %q: %s", k, bounds.name, field, err))
+				break
+			}
+		}
+	}
+	return
+}
+
+func validateCronItem(item string, min, max int) error {
+	rangePart, stepPart, hasStep := strings.Cut(item, "/")
+	if hasStep {
+		step, err := strconv.Atoi(stepPart)
+		if err != nil || step < 1 {
+			return fmt.Errorf("step %q must be a positive number", stepPart)
+		}
+	}
+
+	if rangePart == "*" {
+		return nil
+	}
+
+	lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+	low, err := strconv.Atoi(lowPart)
+	if err != nil || low < min || low > max {
+		return fmt.Errorf("%q must be a number between %d and %d", lowPart, min, max)
+	}
+	if !isRange {
+		if hasStep {
+			return fmt.Errorf("a step needs \"*\" or a range, got %q", item)
+		}
+		return nil
+	}
+
+	high, err := strconv.Atoi(highPart)
+	if err != nil || high < min || high > max {
+		return fmt.Errorf("%q must be a number between %d and %d", highPart, min, max)
+	}
+	if high < low {
+		return fmt.Errorf("range %q is reversed", rangePart)
+	}
+	return nil
+}
+
+// validateSnapshotTemplate rejects placeholders the backend does not expand.
+func validateSnapshotTemplate(v interface{}, k string) (ws []string, errs []error) {
+	for _, match := range snapshotTemplateVarRegexp.FindAllStringSubmatch(v.(string), -1) {
+		known := false
+		for _, name := range snapshotTemplateVars {
+			if match[1] == name {
+				known = true
+				break
+			}
+		}
+		if !known {
+			errs = append(errs, fmt.Errorf("%q uses unknown placeholder %q, must be one of: {%s}", k, match[0], strings.Join(snapshotTemplateVars, "}, {")))
+		}
+	}
+	return
+}
+
+func expandSnapshotPolicyVolumeIDs(set *schema.Set) []string {
+	volumeIDs := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		volumeIDs = append(volumeIDs, v.(string))
+	}
+	sort.Strings(volumeIDs)
+	return volumeIDs
+}
+
+// listPolicySnapshots returns the snapshots taken by snapshot policies, newest
+// first. Empty policyID or volumeID match any policy or volume.
+func listPolicySnapshots(ctx context.Context, c *client.Client, projectID, policyID, volumeID string) ([]dto.Snapshot, error) {
+	allSnapshots, err := client.ListAll(ctx, c, client.ApiPath.Snapshots(projectID), func(r *dto.ListSnapshotsResponse) []dto.Snapshot { return r.Snapshots })
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshots []dto.Snapshot
+	for _, snap := range allSnapshots {
+		if snap.SnapshotPolicyID == "" {
+			continue
+		}
+		if policyID != "" && snap.SnapshotPolicyID != policyID {
+			continue
+		}
+		if volumeID != "" && snap.VolumeID != volumeID {
+			continue
+		}
+		snapshots = append(snapshots, snap)
+	}
+
+	// Timestamps are ISO 8601 in UTC, so they sort lexically.
+	sort.SliceStable(snapshots, func(i, j int) bool { return snapshots[i].CreatedAt > snapshots[j].CreatedAt })
+
+	return snapshots, nil
+}
+
+func flattenPolicySnapshots(snapshots []dto.Snapshot) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(snapshots))
+	for _, snap := range snapshots {
+		result = append(result, map[string]interface{}{
+			"id":                 snap.ID,
+			"name":               snap.Name,
+			"volume_id":          snap.VolumeID,
+			"snapshot_policy_id": snap.SnapshotPolicyID,
+			"size":               snap.SizeGB,
+			"status":             snap.Status,
+			"created_at":         snap.CreatedAt,
+		})
+	}
+	return result
+}
+
+// policySnapshotSchema describes one element of the computed snapshot lists.
+func policySnapshotSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id":                 {Type: schema.TypeString, Computed: true},
+			"name":               {Type: schema.TypeString, Computed: true},
+			"volume_id":          {Type: schema.TypeString, Computed: true},
+			"snapshot_policy_id": {Type: schema.TypeString, Computed: true},
+			"size":               {Type: schema.TypeInt, Computed: true},
+			"status":             {Type: schema.TypeString, Computed: true},
+			"created_at":         {Type: schema.TypeString, Computed: true},
+		},
+	}
+}
@@ -0,0 +1,51 @@
+package snapshotpolicy
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DataSourceSnapshotPolicySnapshots lists the snapshots taken by snapshot
+// policies, newest first.
+func DataSourceSnapshotPolicySnapshots() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSnapshotPolicySnapshotsRead,
+		Schema: map[string]*schema.Schema{
+			"volume_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				AtLeastOneOf: []string{"volume_id", "snapshot_policy_id"},
+			},
+			"snapshot_policy_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     policySnapshotSchema(),
+			},
+		},
+	}
+}
+
+func dataSourceSnapshotPolicySnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	volumeID := d.Get("volume_id").(string)
+	policyID := d.Get("snapshot_policy_id").(string)
+
+	snapshots, err := listPolicySnapshots(ctx, cfg.Client, cfg.ProjectID, policyID, volumeID)
+	if err != nil {
+		return diag.Errorf("Error listing vnpaycloud_snapshot_policy_snapshots: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("snapshot-policy-snapshots-%s-%s-%s", cfg.ProjectID, policyID, volumeID))
+	d.Set("snapshots", flattenPolicySnapshots(snapshots))
+
+	return nil
+}
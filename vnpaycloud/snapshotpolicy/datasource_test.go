@@ -0,0 +1,59 @@
+package snapshotpolicy
+
+import (
+	"context"
+	"testing"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataSourceSnapshotPolicySnapshotsRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantIDs []string
+	}{
+		{
+			name:    "by volume",
+			raw:     map[string]interface{}{"volume_id": "vol-001"},
+			wantIDs: []string{"snap-004", "snap-002", "snap-001"},
+		},
+		{
+			name:    "by volume and policy",
+			raw:     map[string]interface{}{"volume_id": "vol-001", "snapshot_policy_id": "sp-001"},
+			wantIDs: []string{"snap-002", "snap-001"},
+		},
+		{
+			name:    "by policy",
+			raw:     map[string]interface{}{"snapshot_policy_id": "sp-002"},
+			wantIDs: []string{"snap-004"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := testhelpers.NewMockServer(t, []testhelpers.Route{testSnapshotListRoute(t)})
+			cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+			ds := DataSourceSnapshotPolicySnapshots()
+			d := schema.TestResourceDataRaw(t, ds.Schema, tt.raw)
+
+			diags := ds.ReadContext(context.Background(), d, cfg)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			snapshots := d.Get("snapshots").([]interface{})
+			if len(snapshots) != len(tt.wantIDs) {
+				t.Fatalf("expected %d snapshots, got %d: %v", len(tt.wantIDs), len(snapshots), snapshots)
+			}
+			for i, want := range tt.wantIDs {
+				if got := snapshots[i].(map[string]interface{})["id"].(string); got != want {
+					t.Errorf("expected snapshots[%d] %s, got %s", i, want, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package snapshotpolicy
+
+import (
+	"context"
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceSnapshotPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSnapshotPolicyCreate,
+		ReadContext:   resourceSnapshotPolicyRead,
+		UpdateContext: resourceSnapshotPolicyUpdate,
+		DeleteContext: resourceSnapshotPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"volume_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"schedule": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCronSchedule,
+			},
+			"timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "UTC",
+			},
+			"retention_count": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"snapshot_name_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "{volume_name}-{timestamp}",
+				ValidateFunc: validateSnapshotTemplate,
+			},
+			"snapshot_description_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateSnapshotTemplate,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_run_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_run_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     policySnapshotSchema(),
+			},
+		},
+	}
+}
+
+func resourceSnapshotPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	createOpts := dto.CreateSnapshotPolicyRequest{
+		Name:                        d.Get("name").(string),
+		Description:                 d.Get("description").(string),
+		Schedule:                    d.Get("schedule").(string),
+		Timezone:                    d.Get("timezone").(string),
+		RetentionCount:              int32(d.Get("retention_count").(int)),
+		SnapshotNameTemplate:        d.Get("snapshot_name_template").(string),
+		SnapshotDescriptionTemplate: d.Get("snapshot_description_template").(string),
+		VolumeIDs:                   expandSnapshotPolicyVolumeIDs(d.Get("volume_ids").(*schema.Set)),
+		Enabled:                     d.Get("enabled").(bool),
+	}
+
+	tflog.Debug(ctx, "vnpaycloud_snapshot_policy create options", map[string]interface{}{"create_opts": createOpts})
+
+	createResp := &dto.SnapshotPolicyResponse{}
+	_, err := cfg.Client.Post(ctx, client.ApiPath.SnapshotPolicies(cfg.ProjectID), createOpts, createResp, nil)
+	if err != nil {
+		return diag.Errorf("Error creating vnpaycloud_snapshot_policy: %s", err)
+	}
+
+	d.SetId(createResp.SnapshotPolicy.ID)
+
+	return resourceSnapshotPolicyRead(ctx, d, meta)
+}
+
+func resourceSnapshotPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	policyResp := &dto.SnapshotPolicyResponse{}
+	_, err := cfg.Client.Get(ctx, client.ApiPath.SnapshotPolicyWithID(cfg.ProjectID, d.Id()), policyResp, nil)
+	if err != nil {
+		return diag.FromErr(util.CheckNotFound(d, err, "Error retrieving vnpaycloud_snapshot_policy"))
+	}
+
+	tflog.Debug(ctx, "Retrieved vnpaycloud_snapshot_policy "+d.Id(), map[string]interface{}{"snapshot_policy": policyResp.SnapshotPolicy})
+
+	snapshots, err := listPolicySnapshots(ctx, cfg.Client, cfg.ProjectID, d.Id(), "")
+	if err != nil {
+		return diag.Errorf("Error listing snapshots of vnpaycloud_snapshot_policy %s: %s", d.Id(), err)
+	}
+
+	policy := policyResp.SnapshotPolicy
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("volume_ids", policy.VolumeIDs)
+	d.Set("schedule", policy.Schedule)
+	d.Set("timezone", policy.Timezone)
+	d.Set("retention_count", policy.RetentionCount)
+	d.Set("snapshot_name_template", policy.SnapshotNameTemplate)
+	d.Set("snapshot_description_template", policy.SnapshotDescriptionTemplate)
+	d.Set("enabled", policy.Enabled)
+	d.Set("status", policy.Status)
+	d.Set("last_run_at", policy.LastRunAt)
+	d.Set("next_run_at", policy.NextRunAt)
+	d.Set("created_at", policy.CreatedAt)
+	d.Set("snapshots", flattenPolicySnapshots(snapshots))
+
+	return nil
+}
+
+func resourceSnapshotPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	updateOpts := dto.UpdateSnapshotPolicyRequest{
+		Name:                        d.Get("name").(string),
+		Description:                 d.Get("description").(string),
+		Schedule:                    d.Get("schedule").(string),
+		Timezone:                    d.Get("timezone").(string),
+		RetentionCount:              int32(d.Get("retention_count").(int)),
+		SnapshotNameTemplate:        d.Get("snapshot_name_template").(string),
+		SnapshotDescriptionTemplate: d.Get("snapshot_description_template").(string),
+		VolumeIDs:                   expandSnapshotPolicyVolumeIDs(d.Get("volume_ids").(*schema.Set)),
+		Enabled:                     d.Get("enabled").(bool),
+	}
+
+	tflog.Debug(ctx, "vnpaycloud_snapshot_policy update options", map[string]interface{}{"update_opts": updateOpts})
+
+	_, err := cfg.Client.Put(ctx, client.ApiPath.SnapshotPolicyWithID(cfg.ProjectID, d.Id()), updateOpts, nil, nil)
+	if err != nil {
+		return diag.Errorf("Error updating vnpaycloud_snapshot_policy %s: %s", d.Id(), err)
+	}
+
+	return resourceSnapshotPolicyRead(ctx, d, meta)
+}
+
+func resourceSnapshotPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	// Snapshots already taken by the policy are kept.
+	if _, err := cfg.Client.Delete(ctx, client.ApiPath.SnapshotPolicyWithID(cfg.ProjectID, d.Id()), nil); err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vnpaycloud_snapshot_policy"))
+	}
+
+	return nil
+}
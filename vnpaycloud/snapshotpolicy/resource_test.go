@@ -0,0 +1,251 @@
+package snapshotpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testSnapshotPolicy returns a fully populated dto.SnapshotPolicy for use in tests.
+func testSnapshotPolicy() dto.SnapshotPolicy {
+	return dto.SnapshotPolicy{
+		ID:                   "sp-001",
+		Name:                 "nightly",
+		Schedule:             "0 2 * * *",
+		Timezone:             "Asia/Ho_Chi_Minh",
+		RetentionCount:       7,
+		SnapshotNameTemplate: "{volume_name}-{date}",
+		VolumeIDs:            []string{"vol-001", "vol-002"},
+		Enabled:              true,
+		Status:               "active",
+		NextRunAt:            "2025-01-16T02:00:00Z",
+		CreatedAt:            "2025-01-15T10:00:00Z",
+		ProjectID:            testhelpers.TestProjectID,
+	}
+}
+
+// testPolicySnapshots mixes policy snapshots with a manual one.
+func testPolicySnapshots() []dto.Snapshot {
+	return []dto.Snapshot{
+		{ID: "snap-001", Name: "data-2025-01-14", VolumeID: "vol-001", SnapshotPolicyID: "sp-001", Status: "available", CreatedAt: "2025-01-14T02:00:00Z"},
+		{ID: "snap-manual", Name: "manual", VolumeID: "vol-001", Status: "available", CreatedAt: "2025-01-14T12:00:00Z"},
+		{ID: "snap-002", Name: "data-2025-01-15", VolumeID: "vol-001", SnapshotPolicyID: "sp-001", Status: "available", CreatedAt: "2025-01-15T02:00:00Z"},
+		{ID: "snap-003", Name: "logs-2025-01-15", VolumeID: "vol-002", SnapshotPolicyID: "sp-001", Status: "available", CreatedAt: "2025-01-15T02:00:05Z"},
+		{ID: "snap-004", Name: "other-policy", VolumeID: "vol-001", SnapshotPolicyID: "sp-002", Status: "available", CreatedAt: "2025-01-15T03:00:00Z"},
+	}
+}
+
+func testSnapshotListRoute(t *testing.T) testhelpers.Route {
+	return testhelpers.Route{
+		Method:  "GET",
+		Pattern: "/v2/iac/projects/test-project-id/snapshots",
+		Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListSnapshotsResponse{Snapshots: testPolicySnapshots()}),
+	}
+}
+
+func TestResourceSnapshotPolicyCreate(t *testing.T) {
+	policy := testSnapshotPolicy()
+
+	var createBody dto.CreateSnapshotPolicyRequest
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/snapshot-policies",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&createBody); err != nil {
+					t.Errorf("failed to decode create body: %v", err)
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotPolicyResponse{SnapshotPolicy: policy})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/snapshot-policies/sp-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotPolicyResponse{SnapshotPolicy: policy}),
+		},
+		testSnapshotListRoute(t),
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSnapshotPolicy()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":                   "nightly",
+		"volume_ids":             []interface{}{"vol-002", "vol-001"},
+		"schedule":               "0 2 * * *",
+		"timezone":               "Asia/Ho_Chi_Minh",
+		"retention_count":        7,
+		"snapshot_name_template": "{volume_name}-{date}",
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "sp-001" {
+		t.Errorf("expected ID sp-001, got %s", d.Id())
+	}
+	if createBody.RetentionCount != 7 || createBody.Schedule != "0 2 * * *" || !createBody.Enabled {
+		t.Errorf("unexpected create body: %+v", createBody)
+	}
+	if len(createBody.VolumeIDs) != 2 || createBody.VolumeIDs[0] != "vol-001" || createBody.VolumeIDs[1] != "vol-002" {
+		t.Errorf("expected volume IDs [vol-001 vol-002], got %v", createBody.VolumeIDs)
+	}
+	if v := d.Get("next_run_at").(string); v != "2025-01-16T02:00:00Z" {
+		t.Errorf("expected next_run_at 2025-01-16T02:00:00Z, got %s", v)
+	}
+
+	snapshots := d.Get("snapshots").([]interface{})
+	wantIDs := []string{"snap-003", "snap-002", "snap-001"}
+	if len(snapshots) != len(wantIDs) {
+		t.Fatalf("expected %d snapshots, got %d: %v", len(wantIDs), len(snapshots), snapshots)
+	}
+	for i, want := range wantIDs {
+		if got := snapshots[i].(map[string]interface{})["id"].(string); got != want {
+			t.Errorf("expected snapshots[%d] %s, got %s", i, want, got)
+		}
+	}
+}
+
+func TestResourceSnapshotPolicyUpdate(t *testing.T) {
+	policy := testSnapshotPolicy()
+	policy.Enabled = false
+	policy.RetentionCount = 14
+
+	var updateBody dto.UpdateSnapshotPolicyRequest
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Pattern: "/v2/iac/projects/test-project-id/snapshot-policies/sp-001",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case "PUT":
+					if err := json.NewDecoder(r.Body).Decode(&updateBody); err != nil {
+						t.Errorf("failed to decode update body: %v", err)
+					}
+					w.WriteHeader(http.StatusOK)
+				case "GET":
+					testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotPolicyResponse{SnapshotPolicy: policy})(w, r)
+				default:
+					http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+				}
+			},
+		},
+		testSnapshotListRoute(t),
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSnapshotPolicy()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":            "nightly",
+		"volume_ids":      []interface{}{"vol-001", "vol-002"},
+		"schedule":        "0 2 * * *",
+		"retention_count": 14,
+		"enabled":         false,
+	})
+	d.SetId("sp-001")
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if updateBody.Enabled {
+		t.Error("expected enabled false in update body")
+	}
+	if updateBody.RetentionCount != 14 {
+		t.Errorf("expected retention_count 14, got %d", updateBody.RetentionCount)
+	}
+	if v := d.Get("enabled").(bool); v {
+		t.Error("expected enabled false, got true")
+	}
+}
+
+func TestResourceSnapshotPolicyRead_NotFound(t *testing.T) {
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/snapshot-policies/sp-gone",
+			Handler: testhelpers.EmptyHandler(http.StatusNotFound),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSnapshotPolicy()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	d.SetId("sp-gone")
+
+	diags := res.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error on 404: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Errorf("expected resource ID to be cleared after 404, got %s", d.Id())
+	}
+}
+
+func TestValidateCronSchedule(t *testing.T) {
+	tests := []struct {
+		schedule string
+		wantErr  bool
+	}{
+		{"0 2 * * *", false},
+		{"*/15 * * * *", false},
+		{"0 0-23/6 * * 1-5", false},
+		{"30 1 1,15 * 0", false},
+		{"0 2 * *", true},
+		{"0 2 * * * *", true},
+		{"60 2 * * *", true},
+		{"0 24 * * *", true},
+		{"0 2 0 * *", true},
+		{"0 2 * 13 *", true},
+		{"0 2 * * 7", true},
+		{"*/0 * * * *", true},
+		{"5/10 * * * *", true},
+		{"0 5-2 * * *", true},
+		{"a b c d e", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			_, errs := validateCronSchedule(tt.schedule, "schedule")
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("unexpected errors: %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateSnapshotTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		wantErr  bool
+	}{
+		{"{volume_name}-{timestamp}", false},
+		{"backup of {volume_id} by {policy_name} on {date}", false},
+		{"static-name", false},
+		{"{volume}-{timestamp}", true},
+		{"{}", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			_, errs := validateSnapshotTemplate(tt.template, "snapshot_name_template")
+			if tt.wantErr && len(errs) == 0 {
+				t.Error("expected error, got none")
+			}
+			if !tt.wantErr && len(errs) > 0 {
+				t.Errorf("unexpected errors: %v", errs)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -75,8 +76,7 @@ func dataSourceSubnetRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	// List and filter client-side
-	listResp := &dto.ListSubnetsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Subnets(cfg.ProjectID), listResp, nil)
+	subnets, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Subnets(cfg.ProjectID), func(r *dto.ListSubnetsResponse) []dto.Subnet { return r.Subnets })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_subnet: %s", err)
 	}
@@ -84,7 +84,7 @@ func dataSourceSubnetRead(ctx context.Context, d *schema.ResourceData, meta inte
 	nameFilter, nameOk := d.GetOk("name")
 	vpcFilter, vpcOk := d.GetOk("vpc_id")
 
-	for _, s := range listResp.Subnets {
+	for _, s := range subnets {
 		if nameOk && s.Name != nameFilter.(string) {
 			continue
 		}
@@ -115,6 +115,8 @@ func DataSourceSubnets() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceSubnetsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -144,15 +146,31 @@ func DataSourceSubnets() *schema.Resource {
 func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListSubnetsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Subnets(cfg.ProjectID), listResp, nil)
+	filters, nameRegex := util.DataSourceFilters(d)
+	vpcFilter, vpcOk := d.GetOk("vpc_id")
+
+	listOpts := dto.ListSubnetsOpts{}
+	util.SetFilterQuery(&listOpts, filters)
+	if vpcOk {
+		listOpts.VpcID = vpcFilter.(string)
+	}
+	listPath, err := client.WithQuery(client.ApiPath.Subnets(cfg.ProjectID), listOpts)
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_subnets: %s", err)
 	}
 
-	vpcFilter, vpcOk := d.GetOk("vpc_id")
+	allSubnets, err := client.ListAll(ctx, cfg.Client, listPath, func(r *dto.ListSubnetsResponse) []dto.Subnet { return r.Subnets })
+	if err != nil {
+		return diag.Errorf("Error listing vnpaycloud_subnets: %s", err)
+	}
+
+	allSubnets, err = util.FilterItems(allSubnets, filters, "name", nameRegex)
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_subnets: %s", err)
+	}
+
 	var subnets []map[string]interface{}
-	for _, s := range listResp.Subnets {
+	for _, s := range allSubnets {
 		if vpcOk && s.VpcID != vpcFilter.(string) {
 			continue
 		}
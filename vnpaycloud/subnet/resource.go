@@ -46,6 +46,7 @@ func ResourceSubnet() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: util.SetTagsDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -112,6 +113,8 @@ func ResourceSubnet() *schema.Resource {
 					},
 				},
 			},
+			"tags":     util.TagsSchema(),
+			"tags_all": util.TagsAllSchema(),
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -141,6 +144,7 @@ func resourceSubnetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		UsedByK8S:      d.Get("used_by_k8s").(bool),
 		UsedBySI:       d.Get("used_by_si").(bool),
 		DNSNameservers: dnsNameservers,
+		Tags:           util.GetTagsAll(d, meta),
 	}
 
 	tflog.Debug(ctx, "vnpaycloud_subnet create options", map[string]interface{}{"create_opts": createOpts})
@@ -198,6 +202,7 @@ func resourceSubnetRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("route", flattenSubnetRoutes(subnetResp.Subnet.Routes))
 	d.Set("status", subnetResp.Subnet.Status)
 	d.Set("created_at", subnetResp.Subnet.CreatedAt)
+	util.SetResourceTags(d, meta, subnetResp.Subnet.Tags)
 
 	return nil
 }
@@ -230,6 +235,16 @@ func resourceSubnetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		tagsOpts := dto.UpdateTagsRequest{Tags: util.GetTagsAll(d, meta)}
+
+		tflog.Debug(ctx, "vnpaycloud_subnet update tags options", map[string]interface{}{"tags_opts": tagsOpts})
+
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.SubnetTags(cfg.ProjectID, d.Id()), tagsOpts, nil, nil); err != nil {
+			return diag.Errorf("Error updating tags for vnpaycloud_subnet %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceSubnetRead(ctx, d, meta)
 }
 
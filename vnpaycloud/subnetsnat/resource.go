@@ -3,6 +3,7 @@ package subnetsnat
 import (
 	"context"
 	"fmt"
+	"strings"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
@@ -17,6 +18,9 @@ func ResourceSubnetSNAT() *schema.Resource {
 		CreateContext: resourceSubnetSNATCreate,
 		ReadContext:   resourceSubnetSNATRead,
 		DeleteContext: resourceSubnetSNATDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSubnetSNATImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"subnet_id": {
 				Type:     schema.TypeString,
@@ -108,3 +112,28 @@ func resourceSubnetSNATDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	return nil
 }
+
+// resourceSubnetSNATImport accepts either <subnet_id> or the resource ID
+// <subnet_id>/snat.
+func resourceSubnetSNATImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	cfg := meta.(*config.Config)
+
+	subnetID := strings.TrimSuffix(d.Id(), "/snat")
+	if subnetID == "" || strings.Contains(subnetID, "/") {
+		return nil, fmt.Errorf("import id must be <subnet_id>, got: %s", d.Id())
+	}
+
+	subnetResp := &dto.SubnetResponse{}
+	if _, err := cfg.Client.Get(ctx, client.ApiPath.SubnetWithID(cfg.ProjectID, subnetID), subnetResp, nil); err != nil {
+		return nil, fmt.Errorf("vnpaycloud_subnet_snat: subnet %q not found: %w", subnetID, err)
+	}
+	if !subnetResp.Subnet.EnableSnat {
+		return nil, fmt.Errorf("vnpaycloud_subnet_snat: SNAT is not enabled for subnet %s", subnetID)
+	}
+
+	d.SetId(fmt.Sprintf("%s/snat", subnetID))
+	d.Set("subnet_id", subnetID)
+	d.Set("floating_ip_id", subnetResp.Subnet.ExternalIpID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -267,3 +267,65 @@ func TestResourceSubnetSNATDelete(t *testing.T) {
 		t.Error("expected disable-snat PUT to have been called")
 	}
 }
+
+func TestResourceSubnetSNATImport(t *testing.T) {
+	subnet := testSubnetWithSNAT()
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/subnets/subnet-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SubnetResponse{Subnet: subnet}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSubnetSNAT()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	d.SetId("subnet-001")
+
+	results, err := res.Importer.StateContext(context.Background(), d, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+
+	if d.Id() != "subnet-001/snat" {
+		t.Errorf("expected ID subnet-001/snat, got %s", d.Id())
+	}
+	if v := d.Get("subnet_id").(string); v != "subnet-001" {
+		t.Errorf("expected subnet_id subnet-001, got %s", v)
+	}
+	if v := d.Get("floating_ip_id").(string); v != "fip-001" {
+		t.Errorf("expected floating_ip_id fip-001, got %s", v)
+	}
+}
+
+func TestResourceSubnetSNATImport_Disabled(t *testing.T) {
+	subnet := testSubnetWithSNAT()
+	subnet.EnableSnat = false
+	subnet.ExternalIpID = ""
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/subnets/subnet-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SubnetResponse{Subnet: subnet}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceSubnetSNAT()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{})
+	d.SetId("subnet-001")
+
+	_, err := res.Importer.StateContext(context.Background(), d, cfg)
+	if err == nil {
+		t.Fatal("expected error when SNAT is not enabled, got nil")
+	}
+	if !strings.Contains(err.Error(), "not enabled") {
+		t.Errorf("expected 'not enabled' in error, got: %v", err)
+	}
+}
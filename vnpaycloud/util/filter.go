@@ -0,0 +1,378 @@
+package util
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Filter is a single `filter { name = ..., values = [...] }` block of a
+// plural data source.
+type Filter struct {
+	Name   string
+	Values []string
+}
+
+// DataSourceFiltersSchema returns the schema for the repeatable `filter`
+// block of plural data sources.
+func DataSourceFiltersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Only return items whose attribute `name` matches one of `values`. Multiple filter blocks must all match.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "Attribute of the listed items to filter on, e.g. `status` or `tags.env`.",
+				},
+				"values": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Accepted values. `*` and `?` wildcards are supported.",
+				},
+			},
+		},
+	}
+}
+
+// DataSourceNameRegexSchema returns the schema for the `name_regex`
+// argument of plural data sources.
+func DataSourceNameRegexSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsValidRegExp,
+		Description:  "Regular expression the item name must match.",
+	}
+}
+
+// ExpandFilters converts the raw `filter` set into Filters.
+func ExpandFilters(raw []interface{}) []Filter {
+	filters := make([]Filter, 0, len(raw))
+	for _, r := range raw {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		f := Filter{Name: m["name"].(string)}
+		for _, v := range m["values"].([]interface{}) {
+			if s, ok := v.(string); ok {
+				f.Values = append(f.Values, s)
+			}
+		}
+		filters = append(filters, f)
+	}
+	return filters
+}
+
+// DataSourceFilters returns the `filter` blocks and `name_regex` of a plural
+// data source.
+func DataSourceFilters(d *schema.ResourceData) ([]Filter, string) {
+	var filters []Filter
+	if v, ok := d.GetOk("filter"); ok {
+		filters = ExpandFilters(v.(*schema.Set).List())
+	}
+
+	var nameRegex string
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = v.(string)
+	}
+
+	return filters, nameRegex
+}
+
+// ApplyDataSourceFilters returns the items matching the `filter` blocks and
+// `name_regex` of a plural data source. nameField is the attribute
+// `name_regex` is matched against; it is ignored when the data source has no
+// `name_regex` argument.
+func ApplyDataSourceFilters[T any](d *schema.ResourceData, items []T, nameField string) ([]T, error) {
+	filters, nameRegex := DataSourceFilters(d)
+	return FilterItems(items, filters, nameField, nameRegex)
+}
+
+// HasFilter reports whether one of filters is on the attribute name.
+func HasFilter(filters []Filter, name string) bool {
+	for _, f := range filters {
+		if normalizeFilterName(f.Name) == normalizeFilterName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetFilterQuery copies filters onto the string fields of opts, a pointer to
+// a list options struct whose `q` tags name the query parameters the list
+// endpoint supports. Only filters with a single literal value are sent; the
+// rest narrow nothing server-side. The listed items must still be passed
+// through FilterItems, so an endpoint ignoring a parameter stays correct.
+func SetFilterQuery(opts any, filters []Filter) {
+	v := reflect.ValueOf(opts).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		param := strings.Split(f.Tag.Get("q"), ",")[0]
+		if param == "" || f.Type.Kind() != reflect.String {
+			continue
+		}
+		for _, filter := range filters {
+			if normalizeFilterName(filter.Name) != normalizeFilterName(param) || len(filter.Values) != 1 {
+				continue
+			}
+			if value := filter.Values[0]; !strings.ContainsAny(value, `*?[\`) {
+				v.Field(i).SetString(value)
+			}
+		}
+	}
+}
+
+// FilterItems returns the items matching every filter and, when nameRegex is
+// set, whose nameField attribute matches nameRegex. Items are dto structs;
+// filter names refer to their JSON attributes in either snake_case or
+// camelCase, including those of embedded structs. Status and state
+// attributes are matched case-insensitively. Items that are plain strings are
+// matched by value, using the filter name `value`.
+func FilterItems[T any](items []T, filters []Filter, nameField, nameRegex string) ([]T, error) {
+	if len(filters) == 0 && nameRegex == "" {
+		return items, nil
+	}
+
+	itemType := reflect.TypeOf((*T)(nil)).Elem()
+
+	// Resolve every attribute up front so a typo fails even on empty lists.
+	matchers := make([]fieldMatcher, 0, len(filters)+1)
+	for _, f := range filters {
+		m, err := newFieldMatcher(itemType, f.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range f.Values {
+			if _, err := path.Match(v, ""); err != nil {
+				return nil, fmt.Errorf("invalid value %q for filter %q: %s", v, f.Name, err)
+			}
+		}
+		m.values = f.Values
+		if m.foldCase {
+			m.values = make([]string, len(f.Values))
+			for i, v := range f.Values {
+				m.values[i] = strings.ToLower(v)
+			}
+		}
+		matchers = append(matchers, m)
+	}
+
+	if nameRegex != "" {
+		re, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_regex %q: %s", nameRegex, err)
+		}
+		m, err := newFieldMatcher(itemType, nameField)
+		if err != nil {
+			return nil, fmt.Errorf("name_regex: %s", err)
+		}
+		m.regex = re
+		matchers = append(matchers, m)
+	}
+
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		v := reflect.ValueOf(item)
+		matched := true
+		for _, m := range matchers {
+			if !m.match(v) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
+
+// fieldMatcher matches one attribute of an item against either a list of
+// glob values or a regular expression.
+type fieldMatcher struct {
+	index    []int  // struct field index; nil when the item itself is matched
+	mapKey   string // key looked up when the field is a map, e.g. tags.env
+	foldCase bool   // enum-like attribute whose case varies, e.g. Running
+	values   []string
+	regex    *regexp.Regexp
+}
+
+func newFieldMatcher(t reflect.Type, name string) (fieldMatcher, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		if normalizeFilterName(name) != "value" {
+			return fieldMatcher{}, fmt.Errorf("unknown filter name %q, valid names: value", name)
+		}
+		return fieldMatcher{}, nil
+	}
+
+	attr, key, hasKey := strings.Cut(name, ".")
+	want := normalizeFilterName(attr)
+
+	var valid []string
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() {
+			continue
+		}
+		jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+		if jsonName == "-" {
+			continue
+		}
+		if jsonName == "" {
+			jsonName = f.Name
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if !isFilterableKind(ft) {
+			continue
+		}
+		valid = append(valid, toSnakeCase(jsonName))
+
+		if normalizeFilterName(jsonName) != want && normalizeFilterName(f.Name) != want {
+			continue
+		}
+		if (ft.Kind() == reflect.Map) != hasKey {
+			if hasKey {
+				return fieldMatcher{}, fmt.Errorf("filter %q: %s is not a map attribute", name, attr)
+			}
+			return fieldMatcher{}, fmt.Errorf("filter %q: %s is a map attribute, use %s.<key>", name, attr, attr)
+		}
+		return fieldMatcher{index: f.Index, mapKey: key, foldCase: isEnumLikeFilterName(jsonName)}, nil
+	}
+
+	sort.Strings(valid)
+	return fieldMatcher{}, fmt.Errorf("unknown filter name %q, valid names: %s", name, strings.Join(valid, ", "))
+}
+
+func (m fieldMatcher) match(item reflect.Value) bool {
+	v := item
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return false
+		}
+		v = v.Elem()
+	}
+	if m.index != nil {
+		v = v.FieldByIndex(m.index)
+	}
+
+	for _, s := range filterValueStrings(v, m.mapKey) {
+		if m.regex != nil {
+			if m.regex.MatchString(s) {
+				return true
+			}
+			continue
+		}
+		if m.foldCase {
+			s = strings.ToLower(s)
+		}
+		for _, pattern := range m.values {
+			if ok, _ := path.Match(pattern, s); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// filterValueStrings returns the string forms of v a filter value is compared
+// with. Lists match when any element matches.
+func filterValueStrings(v reflect.Value, mapKey string) []string {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return []string{v.String()}
+	case reflect.Bool:
+		return []string{strconv.FormatBool(v.Bool())}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []string{strconv.FormatInt(v.Int(), 10)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return []string{strconv.FormatUint(v.Uint(), 10)}
+	case reflect.Float32, reflect.Float64:
+		return []string{strconv.FormatFloat(v.Float(), 'f', -1, 64)}
+	case reflect.Slice, reflect.Array:
+		var out []string
+		for i := 0; i < v.Len(); i++ {
+			out = append(out, filterValueStrings(v.Index(i), "")...)
+		}
+		return out
+	case reflect.Map:
+		mv := v.MapIndex(reflect.ValueOf(mapKey))
+		if !mv.IsValid() {
+			return nil
+		}
+		return filterValueStrings(mv, "")
+	}
+	return nil
+}
+
+func isFilterableKind(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	case reflect.Slice, reflect.Array:
+		return isFilterableKind(t.Elem())
+	case reflect.Map:
+		return t.Key().Kind() == reflect.String && isFilterableKind(t.Elem())
+	}
+	return false
+}
+
+// isEnumLikeFilterName reports whether the attribute holds a status or state
+// the API does not return in a consistent case, e.g. powerState Running.
+func isEnumLikeFilterName(name string) bool {
+	n := normalizeFilterName(name)
+	return strings.HasSuffix(n, "status") || strings.HasSuffix(n, "state")
+}
+
+// normalizeFilterName makes vpc_id, vpcId and VpcID compare equal.
+func normalizeFilterName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "_", ""))
+}
+
+// toSnakeCase converts a camelCase JSON attribute name to snake_case for
+// error messages, e.g. vpcId -> vpc_id.
+func toSnakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 && !(name[i-1] >= 'A' && name[i-1] <= 'Z') {
+				b.WriteByte('_')
+			}
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
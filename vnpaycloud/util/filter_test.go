@@ -0,0 +1,177 @@
+package util
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type filterTestItem struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Status     string            `json:"status"`
+	VpcID      string            `json:"vpcId"`
+	FlavorName string            `json:"flavorName"`
+	Size       int               `json:"size"`
+	Shared     bool              `json:"shared"`
+	SubnetIDs  []string          `json:"subnetIds"`
+	Tags       map[string]string `json:"tags,omitempty"`
+}
+
+func filterTestItems() []filterTestItem {
+	return []filterTestItem{
+		{ID: "1", Name: "web-1", Status: "running", VpcID: "vpc-a", FlavorName: "c2.small", Size: 10, SubnetIDs: []string{"sn-1"}, Tags: map[string]string{"env": "prod"}},
+		{ID: "2", Name: "web-2", Status: "stopped", VpcID: "vpc-a", FlavorName: "c2.large", Size: 20, SubnetIDs: []string{"sn-2"}},
+		{ID: "3", Name: "db-1", Status: "running", VpcID: "vpc-b", FlavorName: "m1.large", Size: 10, Shared: true, SubnetIDs: []string{"sn-1", "sn-3"}, Tags: map[string]string{"env": "dev"}},
+		{ID: "4", Name: "web-3", Status: "running", VpcID: "vpc-a", FlavorName: "c2.medium", Size: 30},
+	}
+}
+
+func filteredIDs(items []filterTestItem) string {
+	ids := make([]string, 0, len(items))
+	for _, it := range items {
+		ids = append(ids, it.ID)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestFilterItems(t *testing.T) {
+	tests := []struct {
+		name      string
+		filters   []Filter
+		nameRegex string
+		want      string
+	}{
+		{"no filters", nil, "", "1,2,3,4"},
+		{"single value", []Filter{{Name: "status", Values: []string{"running"}}}, "", "1,3,4"},
+		{"values are ORed", []Filter{{Name: "status", Values: []string{"stopped", "missing"}}}, "", "2"},
+		{
+			"filters are ANDed with wildcard and snake_case name",
+			[]Filter{
+				{Name: "status", Values: []string{"running"}},
+				{Name: "vpc_id", Values: []string{"vpc-a"}},
+				{Name: "flavor_name", Values: []string{"c2.*"}},
+			},
+			"", "1,4",
+		},
+		{"camelCase name", []Filter{{Name: "vpcId", Values: []string{"vpc-b"}}}, "", "3"},
+		{"int attribute", []Filter{{Name: "size", Values: []string{"10"}}}, "", "1,3"},
+		{"bool attribute", []Filter{{Name: "shared", Values: []string{"true"}}}, "", "3"},
+		{"list attribute matches any element", []Filter{{Name: "subnet_ids", Values: []string{"sn-1"}}}, "", "1,3"},
+		{"map attribute", []Filter{{Name: "tags.env", Values: []string{"prod"}}}, "", "1"},
+		{"name_regex", nil, "^web-[12]$", "1,2"},
+		{"name_regex with filter", []Filter{{Name: "status", Values: []string{"running"}}}, "^web", "1,4"},
+		{"status ignores case", []Filter{{Name: "status", Values: []string{"Running"}}}, "", "1,3,4"},
+		{"other attributes keep case", []Filter{{Name: "name", Values: []string{"WEB-*"}}}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FilterItems(filterTestItems(), tt.filters, "name", tt.nameRegex)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if ids := filteredIDs(got); ids != tt.want {
+				t.Errorf("FilterItems() = %s, want %s", ids, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterItemsErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		filters   []Filter
+		nameField string
+		nameRegex string
+		wantErr   string
+	}{
+		{"unknown attribute", []Filter{{Name: "colour", Values: []string{"red"}}}, "name", "", `unknown filter name "colour"`},
+		{"map without key", []Filter{{Name: "tags", Values: []string{"x"}}}, "name", "", "use tags.<key>"},
+		{"key on non-map", []Filter{{Name: "status.x", Values: []string{"x"}}}, "name", "", "not a map attribute"},
+		{"bad glob", []Filter{{Name: "status", Values: []string{"[a"}}}, "name", "", "invalid value"},
+		{"bad regex", nil, "name", "(", "invalid name_regex"},
+		{"missing name field", nil, "title", "x", "name_regex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Errors are reported even when there is nothing to filter.
+			_, err := FilterItems([]filterTestItem{}, tt.filters, tt.nameField, tt.nameRegex)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFilterItemsStrings(t *testing.T) {
+	versions := []string{"14", "15", "16"}
+
+	got, err := FilterItems(versions, []Filter{{Name: "value", Values: []string{"1[56]"}}}, "value", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"15", "16"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterItems() = %v, want %v", got, want)
+	}
+
+	got, err = FilterItems(versions, nil, "value", "^1[45]$")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"14", "15"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterItems() with name_regex = %v, want %v", got, want)
+	}
+}
+
+func TestFilterItemsEmbedded(t *testing.T) {
+	type item struct {
+		filterTestItem
+		ZoneIDs []string `json:"zoneIds"`
+	}
+	items := []item{
+		{filterTestItem: filterTestItem{ID: "1", Name: "web-1"}, ZoneIDs: []string{"zone-a"}},
+		{filterTestItem: filterTestItem{ID: "2", Name: "web-2"}, ZoneIDs: []string{"zone-b"}},
+	}
+
+	got, err := FilterItems(items, []Filter{{Name: "zone_ids", Values: []string{"zone-b"}}}, "name", "^web-")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != "2" {
+		t.Errorf("FilterItems() = %+v, want item 2", got)
+	}
+}
+
+func TestSetFilterQuery(t *testing.T) {
+	type listOpts struct {
+		Name   string `q:"name"`
+		VpcID  string `q:"vpcId"`
+		Flavor string `q:"flavorName"`
+		Status string `q:"status"`
+	}
+
+	var opts listOpts
+	SetFilterQuery(&opts, []Filter{
+		{Name: "vpc_id", Values: []string{"vpc-a"}},
+		{Name: "flavor_name", Values: []string{"c2.*"}},
+		{Name: "status", Values: []string{"running", "stopped"}},
+		{Name: "size", Values: []string{"10"}},
+	})
+
+	want := listOpts{VpcID: "vpc-a"}
+	if opts != want {
+		t.Errorf("SetFilterQuery() = %+v, want %+v", opts, want)
+	}
+}
+
+func TestExpandFilters(t *testing.T) {
+	got := ExpandFilters([]interface{}{
+		map[string]interface{}{"name": "status", "values": []interface{}{"running", "stopped"}},
+	})
+	want := []Filter{{Name: "status", Values: []string{"running", "stopped"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandFilters() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,103 @@
+package util
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+)
+
+// TagsSchema returns the schema for the user-managed `tags` argument of a
+// taggable resource.
+func TagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Tags assigned to the resource. Merged with the provider-level default_tags.",
+	}
+}
+
+// TagsAllSchema returns the schema for the computed `tags_all` attribute,
+// which holds the provider default_tags merged with the resource `tags`.
+func TagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "All tags of the resource, including those inherited from the provider default_tags.",
+	}
+}
+
+// ExpandTags converts a raw TypeMap value into a map[string]string.
+func ExpandTags(raw map[string]interface{}) map[string]string {
+	tags := make(map[string]string, len(raw))
+	for k, v := range raw {
+		if s, ok := v.(string); ok {
+			tags[k] = s
+		}
+	}
+	return tags
+}
+
+// MergeTags returns the default tags overlaid with the resource tags.
+// Resource tags win when a key is present in both.
+func MergeTags(defaults, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(defaults)+len(tags))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// GetTagsAll returns the full tag set that should be sent to the backend for
+// the resource: the provider default_tags merged with the resource `tags`.
+func GetTagsAll(d *schema.ResourceData, meta interface{}) map[string]string {
+	cfg := meta.(*config.Config)
+	return MergeTags(cfg.DefaultTags, ExpandTags(d.Get("tags").(map[string]interface{})))
+}
+
+// SetTagsDiff is a CustomizeDiff function that computes `tags_all` at plan
+// time so the merged result of default_tags and `tags` shows in the plan.
+func SetTagsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	cfg := meta.(*config.Config)
+
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	tagsAll := MergeTags(cfg.DefaultTags, ExpandTags(d.Get("tags").(map[string]interface{})))
+	current := ExpandTags(d.Get("tags_all").(map[string]interface{}))
+
+	if reflect.DeepEqual(tagsAll, current) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", tagsAll)
+}
+
+// SetResourceTags stores the tags returned by the backend. `tags_all` is set
+// to the full remote tag set, while `tags` excludes entries inherited from
+// default_tags unless they are also explicitly configured on the resource.
+func SetResourceTags(d *schema.ResourceData, meta interface{}, remote map[string]string) {
+	cfg := meta.(*config.Config)
+	configured := ExpandTags(d.Get("tags").(map[string]interface{}))
+
+	tags := make(map[string]string, len(remote))
+	for k, v := range remote {
+		if dv, ok := cfg.DefaultTags[k]; ok && dv == v {
+			if cv, ok := configured[k]; !ok || cv != v {
+				continue
+			}
+		}
+		tags[k] = v
+	}
+
+	d.Set("tags", tags)
+	d.Set("tags_all", remote)
+}
@@ -0,0 +1,73 @@
+package util
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+)
+
+func TestMergeTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		defaults map[string]string
+		tags     map[string]string
+		want     map[string]string
+	}{
+		{"both nil", nil, nil, map[string]string{}},
+		{"defaults only", map[string]string{"env": "prod"}, nil, map[string]string{"env": "prod"}},
+		{"tags only", nil, map[string]string{"app": "web"}, map[string]string{"app": "web"}},
+		{
+			"resource tag wins",
+			map[string]string{"env": "prod", "owner": "platform"},
+			map[string]string{"owner": "web"},
+			map[string]string{"env": "prod", "owner": "web"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeTags(tt.defaults, tt.tags)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeTags() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandTags(t *testing.T) {
+	got := ExpandTags(map[string]interface{}{"env": "prod", "bad": 1})
+	want := map[string]string{"env": "prod"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExpandTags() = %v, want %v", got, want)
+	}
+}
+
+func TestSetResourceTags(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"tags":     TagsSchema(),
+		"tags_all": TagsAllSchema(),
+	}
+	cfg := &config.Config{DefaultTags: map[string]string{"env": "prod", "owner": "platform"}}
+
+	d := schema.TestResourceDataRaw(t, s, map[string]interface{}{
+		"tags": map[string]interface{}{"env": "prod", "app": "web"},
+	})
+
+	SetResourceTags(d, cfg, map[string]string{
+		"env":   "prod",
+		"owner": "platform",
+		"app":   "web",
+		"extra": "remote",
+	})
+
+	wantTags := map[string]interface{}{"env": "prod", "app": "web", "extra": "remote"}
+	if got := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(got, wantTags) {
+		t.Errorf("tags = %v, want %v", got, wantTags)
+	}
+	if got := d.Get("tags_all").(map[string]interface{}); len(got) != 4 {
+		t.Errorf("expected 4 entries in tags_all, got %v", got)
+	}
+}
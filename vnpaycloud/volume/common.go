@@ -8,6 +8,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
 	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
@@ -31,3 +32,37 @@ func volumeStateRefreshFunc(ctx context.Context, c *client.Client, projectID, vo
 		return volResp.Volume, volResp.Volume.Status, nil
 	}
 }
+
+// volumeIOPSConfigured reports whether iops is set in the configuration
+// rather than left for the backend to compute.
+func volumeIOPSConfigured(raw cty.Value) bool {
+	return !raw.IsNull() && !raw.GetAttr("iops").IsNull()
+}
+
+// findVolumeTypeByName returns the volume type with the given name in the
+// zone, or nil when there is none.
+func findVolumeTypeByName(ctx context.Context, c *client.Client, zoneID, name string) (*dto.VolumeType, error) {
+	volumeTypes, err := client.ListAll(ctx, c, client.ApiPath.VolumeTypes(zoneID), func(r *dto.ListVolumeTypesResponse) []dto.VolumeType { return r.VolumeTypes })
+	if err != nil {
+		return nil, err
+	}
+
+	for _, vt := range volumeTypes {
+		if vt.Name == name {
+			return &vt, nil
+		}
+	}
+	return nil, nil
+}
+
+// validateVolumeIOPS checks that iops may be requested for volumes of the
+// given type.
+func validateVolumeIOPS(vt *dto.VolumeType, iops int) error {
+	if !vt.SupportsProvisionedIOPS {
+		return fmt.Errorf("volume type %q does not support provisioned iops; remove iops or choose another volume_type", vt.Name)
+	}
+	if (vt.MinIOPS > 0 && iops < int(vt.MinIOPS)) || (vt.MaxIOPS > 0 && iops > int(vt.MaxIOPS)) {
+		return fmt.Errorf("iops (%d) must be between %d and %d for volume type %q", iops, vt.MinIOPS, vt.MaxIOPS, vt.Name)
+	}
+	return nil
+}
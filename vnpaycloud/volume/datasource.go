@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -89,15 +90,14 @@ func dataSourceVolumeRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return setVolumeData(d, &volResp.Volume)
 	}
 
-	listResp := &dto.ListVolumesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Volumes(cfg.ProjectID), listResp, nil)
+	volumes, err := client.ListAll(ctx, cfg.Client, client.ApiPath.Volumes(cfg.ProjectID), func(r *dto.ListVolumesResponse) []dto.Volume { return r.Volumes })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_volume: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, vol := range listResp.Volumes {
+	for _, vol := range volumes {
 		if nameOk && vol.Name != nameFilter.(string) {
 			continue
 		}
@@ -129,6 +129,8 @@ func DataSourceVolumes() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVolumesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"volumes": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -158,14 +160,27 @@ func DataSourceVolumes() *schema.Resource {
 func dataSourceVolumesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListVolumesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.Volumes(cfg.ProjectID), listResp, nil)
+	filters, nameRegex := util.DataSourceFilters(d)
+
+	listOpts := dto.ListVolumesOpts{}
+	util.SetFilterQuery(&listOpts, filters)
+	listPath, err := client.WithQuery(client.ApiPath.Volumes(cfg.ProjectID), listOpts)
+	if err != nil {
+		return diag.Errorf("Error listing vnpaycloud_volumes: %s", err)
+	}
+
+	allVolumes, err := client.ListAll(ctx, cfg.Client, listPath, func(r *dto.ListVolumesResponse) []dto.Volume { return r.Volumes })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_volumes: %s", err)
 	}
 
+	allVolumes, err = util.FilterItems(allVolumes, filters, "name", nameRegex)
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_volumes: %s", err)
+	}
+
 	var volumes []map[string]interface{}
-	for _, vol := range listResp.Volumes {
+	for _, vol := range allVolumes {
 		volumes = append(volumes, map[string]interface{}{
 			"id":                   vol.ID,
 			"name":                 vol.Name,
@@ -2,6 +2,7 @@ package volume
 
 import (
 	"context"
+	"fmt"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceVolume() *schema.Resource {
@@ -23,6 +25,7 @@ func ResourceVolume() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceVolumeCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -44,7 +47,12 @@ func ResourceVolume() *schema.Resource {
 			"volume_type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+			},
+			"migration_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "never",
+				ValidateFunc: validation.StringInSlice([]string{"never", "on-demand"}, false),
 			},
 			"zone": {
 				Type:     schema.TypeString,
@@ -70,8 +78,10 @@ func ResourceVolume() *schema.Resource {
 				Computed: true,
 			},
 			"iops": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"is_encrypted": {
 				Type:     schema.TypeBool,
@@ -93,6 +103,8 @@ func ResourceVolume() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"tags":     util.TagsSchema(),
+			"tags_all": util.TagsAllSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -112,6 +124,11 @@ func resourceVolumeCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		Encrypt:     d.Get("encrypt").(bool),
 		Multiattach: d.Get("multiattach").(bool),
 		SnapshotID:  d.Get("snapshot_id").(string),
+		Tags:        util.GetTagsAll(d, meta),
+	}
+
+	if volumeIOPSConfigured(d.GetRawConfig()) {
+		createOpts.IOPS = int32(d.Get("iops").(int))
 	}
 
 	tflog.Debug(ctx, "vnpaycloud_volume create options", map[string]interface{}{"create_opts": createOpts})
@@ -165,6 +182,7 @@ func resourceVolumeRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("attached_server_id", volResp.Volume.AttachedServerID)
 	d.Set("attached_server_name", volResp.Volume.AttachedServerName)
 	d.Set("created_at", volResp.Volume.CreatedAt)
+	util.SetResourceTags(d, meta, volResp.Volume.Tags)
 
 	return nil
 }
@@ -186,6 +204,16 @@ func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		tagsOpts := dto.UpdateTagsRequest{Tags: util.GetTagsAll(d, meta)}
+
+		tflog.Debug(ctx, "vnpaycloud_volume update tags options", map[string]interface{}{"tags_opts": tagsOpts})
+
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.VolumeTags(cfg.ProjectID, d.Id()), tagsOpts, nil, nil); err != nil {
+			return diag.Errorf("Error updating tags for vnpaycloud_volume %s: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("size") {
 		oldRaw, newRaw := d.GetChange("size")
 		oldSize := oldRaw.(int)
@@ -221,9 +249,90 @@ func resourceVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.HasChange("volume_type") {
+		retypeOpts := dto.RetypeVolumeRequest{
+			VolumeType:      d.Get("volume_type").(string),
+			MigrationPolicy: d.Get("migration_policy").(string),
+		}
+		// Send a configured iops even when unchanged, or the backend gives
+		// the new type its default iops.
+		if volumeIOPSConfigured(d.GetRawConfig()) {
+			retypeOpts.IOPS = int32(d.Get("iops").(int))
+		}
+
+		tflog.Debug(ctx, "vnpaycloud_volume retype options", map[string]interface{}{"retype_opts": retypeOpts})
+
+		if _, err := cfg.Client.Post(ctx, client.ApiPath.VolumeRetype(cfg.ProjectID, d.Id()), retypeOpts, nil, nil); err != nil {
+			return diag.Errorf("Error retyping vnpaycloud_volume %s: %s", d.Id(), err)
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"retyping", "migrating"},
+			Target:     []string{"active", "available", "in-use"},
+			Refresh:    volumeStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("Error waiting for vnpaycloud_volume %s to finish retyping: %s", d.Id(), err)
+		}
+	} else if d.HasChange("iops") {
+		iopsOpts := dto.ModifyVolumeIOPSRequest{IOPS: int32(d.Get("iops").(int))}
+
+		tflog.Debug(ctx, "vnpaycloud_volume modify iops options", map[string]interface{}{"iops_opts": iopsOpts})
+
+		if _, err := cfg.Client.Post(ctx, client.ApiPath.VolumeModifyIOPS(cfg.ProjectID, d.Id()), iopsOpts, nil, nil); err != nil {
+			return diag.Errorf("Error changing iops of vnpaycloud_volume %s: %s", d.Id(), err)
+		}
+
+		stateConf := &retry.StateChangeConf{
+			Pending:    []string{"modifying", "updating"},
+			Target:     []string{"active", "available", "in-use"},
+			Refresh:    volumeStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return diag.Errorf("Error waiting for vnpaycloud_volume %s to finish changing iops: %s", d.Id(), err)
+		}
+	}
+
 	return resourceVolumeRead(ctx, d, meta)
 }
 
+func resourceVolumeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only a configured iops needs the volume type to support provisioning.
+	if volumeIOPSConfigured(d.GetRawConfig()) && d.NewValueKnown("iops") && d.NewValueKnown("volume_type") &&
+		(d.Id() == "" || d.HasChanges("iops", "volume_type")) {
+		cfg := meta.(*config.Config)
+		typeName := d.Get("volume_type").(string)
+
+		volumeType, err := findVolumeTypeByName(ctx, cfg.Client, cfg.ZoneID, typeName)
+		if err != nil {
+			return fmt.Errorf("error looking up volume type %q: %s", typeName, err)
+		}
+		if volumeType == nil {
+			return fmt.Errorf("volume type %q does not exist in zone %s", typeName, cfg.ZoneID)
+		}
+		if err := validateVolumeIOPS(volumeType, d.Get("iops").(int)); err != nil {
+			return err
+		}
+	}
+
+	// A retype may change the iops the backend assigns to the volume.
+	if d.Id() != "" && d.HasChange("volume_type") && !volumeIOPSConfigured(d.GetRawConfig()) {
+		if err := d.SetNewComputed("iops"); err != nil {
+			return err
+		}
+	}
+
+	return util.SetTagsDiff(ctx, d, meta)
+}
+
 func resourceVolumeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
@@ -2,13 +2,17 @@ package volume
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // testVolume returns a fully populated dto.Volume for use in tests.
@@ -220,3 +224,287 @@ func TestResourceVolumeDelete(t *testing.T) {
 		t.Error("expected DELETE to have been called")
 	}
 }
+
+func testVolumeDiffData(t *testing.T, res *schema.Resource, cfg interface{}, state map[string]string, raw map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+
+	is := &terraform.InstanceState{ID: "vol-001", Attributes: state, RawConfig: testVolumeRawConfig(raw)}
+	diff, err := res.Diff(context.Background(), is, terraform.NewResourceConfigRaw(raw), cfg)
+	if err != nil {
+		t.Fatalf("unexpected diff error: %v", err)
+	}
+
+	d, err := schema.InternalMap(res.Schema).Data(is, diff)
+	if err != nil {
+		t.Fatalf("unexpected error building resource data: %v", err)
+	}
+	return d
+}
+
+// testVolumeRawConfig mirrors the part of the raw configuration Terraform
+// would send that the resource inspects to tell a configured iops apart from
+// a computed one.
+func testVolumeRawConfig(raw map[string]interface{}) cty.Value {
+	iops := cty.NullVal(cty.Number)
+	if v, ok := raw["iops"]; ok {
+		iops = cty.NumberIntVal(int64(v.(int)))
+	}
+	return cty.ObjectVal(map[string]cty.Value{"iops": iops})
+}
+
+// testVolumeTypeRoutes serves the volume type catalogue the CustomizeDiff
+// checks iops against.
+func testVolumeTypeRoutes(t *testing.T) []testhelpers.Route {
+	return []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/volume-types",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListVolumeTypesResponse{VolumeTypes: []dto.VolumeType{
+				{ID: "vt-001", Name: "SSD", IOPS: 3000, Zone: testhelpers.TestZoneID},
+				{ID: "vt-002", Name: "NVMe", IOPS: 5000, SupportsProvisionedIOPS: true, MinIOPS: 1000, MaxIOPS: 20000, Zone: testhelpers.TestZoneID},
+			}}),
+		},
+	}
+}
+
+func testVolumeState() map[string]string {
+	return map[string]string{
+		"id":               "vol-001",
+		"name":             "test-volume",
+		"description":      "",
+		"size":             "50",
+		"volume_type":      "SSD",
+		"migration_policy": "never",
+		"iops":             "3000",
+		"encrypt":          "false",
+		"multiattach":      "false",
+	}
+}
+
+func TestResourceVolumeUpdate_Retype(t *testing.T) {
+	vol := testVolume()
+	vol.VolumeType = "NVMe"
+	vol.IOPS = 8000
+
+	var retypeBody dto.RetypeVolumeRequest
+	srv := testhelpers.NewMockServer(t, append(testVolumeTypeRoutes(t), []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001/retype",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&retypeBody); err != nil {
+					t.Errorf("failed to decode retype body: %v", err)
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: vol}),
+		},
+	}...))
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceVolume()
+	d := testVolumeDiffData(t, res, cfg, testVolumeState(), map[string]interface{}{
+		"name":             "test-volume",
+		"size":             50,
+		"volume_type":      "NVMe",
+		"migration_policy": "on-demand",
+		"iops":             8000,
+	})
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if retypeBody.VolumeType != "NVMe" {
+		t.Errorf("expected retype to NVMe, got %q", retypeBody.VolumeType)
+	}
+	if retypeBody.MigrationPolicy != "on-demand" {
+		t.Errorf("expected migration policy on-demand, got %q", retypeBody.MigrationPolicy)
+	}
+	if retypeBody.IOPS != 8000 {
+		t.Errorf("expected retype iops 8000, got %d", retypeBody.IOPS)
+	}
+	if v := d.Get("volume_type").(string); v != "NVMe" {
+		t.Errorf("expected volume_type NVMe, got %s", v)
+	}
+}
+
+// TestResourceVolumeUpdate_RetypeKeepsIOPS verifies that a configured iops
+// is sent with a retype even when it did not change.
+func TestResourceVolumeUpdate_RetypeKeepsIOPS(t *testing.T) {
+	vol := testVolume()
+	vol.VolumeType = "NVMe"
+	vol.IOPS = 3000
+
+	var retypeBody dto.RetypeVolumeRequest
+	iopsCalled := false
+	srv := testhelpers.NewMockServer(t, append(testVolumeTypeRoutes(t), []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001/retype",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&retypeBody); err != nil {
+					t.Errorf("failed to decode retype body: %v", err)
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001/modify-iops",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				iopsCalled = true
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: vol}),
+		},
+	}...))
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceVolume()
+	d := testVolumeDiffData(t, res, cfg, testVolumeState(), map[string]interface{}{
+		"name":        "test-volume",
+		"size":        50,
+		"volume_type": "NVMe",
+		"iops":        3000,
+	})
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if retypeBody.IOPS != 3000 {
+		t.Errorf("expected retype iops 3000, got %d", retypeBody.IOPS)
+	}
+	if iopsCalled {
+		t.Error("expected iops to be sent with the retype, not modified separately")
+	}
+}
+
+func TestResourceVolumeUpdate_ModifyIOPS(t *testing.T) {
+	vol := testVolume()
+	vol.VolumeType = "NVMe"
+	vol.IOPS = 12000
+
+	state := testVolumeState()
+	state["volume_type"] = "NVMe"
+	state["iops"] = "8000"
+
+	var iopsBody dto.ModifyVolumeIOPSRequest
+	retypeCalled := false
+	srv := testhelpers.NewMockServer(t, append(testVolumeTypeRoutes(t), []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001/modify-iops",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&iopsBody); err != nil {
+					t.Errorf("failed to decode modify-iops body: %v", err)
+				}
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001/retype",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				retypeCalled = true
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: vol}),
+		},
+	}...))
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceVolume()
+	d := testVolumeDiffData(t, res, cfg, state, map[string]interface{}{
+		"name":        "test-volume",
+		"size":        50,
+		"volume_type": "NVMe",
+		"iops":        12000,
+	})
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if retypeCalled {
+		t.Error("expected no retype when only iops changes")
+	}
+	if iopsBody.IOPS != 12000 {
+		t.Errorf("expected iops 12000, got %d", iopsBody.IOPS)
+	}
+	if v := d.Get("iops").(int); v != 12000 {
+		t.Errorf("expected iops 12000, got %d", v)
+	}
+}
+
+func TestResourceVolumeCustomizeDiff_IOPS(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     map[string]interface{}
+		wantErr string
+	}{
+		{
+			name:    "unsupported volume type",
+			raw:     map[string]interface{}{"name": "v", "size": 50, "volume_type": "SSD", "iops": 5000},
+			wantErr: "does not support provisioned iops",
+		},
+		{
+			name:    "below minimum",
+			raw:     map[string]interface{}{"name": "v", "size": 50, "volume_type": "NVMe", "iops": 500},
+			wantErr: "must be between 1000 and 20000",
+		},
+		{
+			name:    "above maximum",
+			raw:     map[string]interface{}{"name": "v", "size": 50, "volume_type": "NVMe", "iops": 25000},
+			wantErr: "must be between 1000 and 20000",
+		},
+		{
+			name:    "unknown volume type",
+			raw:     map[string]interface{}{"name": "v", "size": 50, "volume_type": "HDD", "iops": 5000},
+			wantErr: "does not exist",
+		},
+		{
+			name: "within range",
+			raw:  map[string]interface{}{"name": "v", "size": 50, "volume_type": "NVMe", "iops": 5000},
+		},
+		{
+			name: "iops not set",
+			raw:  map[string]interface{}{"name": "v", "size": 50, "volume_type": "SSD"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := testhelpers.NewMockServer(t, testVolumeTypeRoutes(t))
+			cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+			res := ResourceVolume()
+			_, err := res.Diff(context.Background(), &terraform.InstanceState{RawConfig: testVolumeRawConfig(tt.raw)}, terraform.NewResourceConfigRaw(tt.raw), cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package volume
+
+import (
+	"context"
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ResourceVolumeSnapshotRevert reverts a volume to one of its snapshots when
+// created. It is an action: destroying it leaves the volume untouched.
+func ResourceVolumeSnapshotRevert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVolumeSnapshotRevertCreate,
+		ReadContext:   resourceVolumeSnapshotRevertRead,
+		DeleteContext: resourceVolumeSnapshotRevertDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"snapshot_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"volume_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVolumeSnapshotRevertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	volumeID := d.Get("volume_id").(string)
+	snapshotID := d.Get("snapshot_id").(string)
+
+	snapResp := &dto.SnapshotResponse{}
+	if _, err := cfg.Client.Get(ctx, client.ApiPath.SnapshotWithID(cfg.ProjectID, snapshotID), snapResp, nil); err != nil {
+		return diag.Errorf("Error retrieving vnpaycloud_snapshot %s: %s", snapshotID, err)
+	}
+	if snapResp.Snapshot.VolumeID != volumeID {
+		return diag.Errorf("Error reverting vnpaycloud_volume %s: snapshot %s was taken from volume %s", volumeID, snapshotID, snapResp.Snapshot.VolumeID)
+	}
+
+	volResp := &dto.VolumeResponse{}
+	if _, err := cfg.Client.Get(ctx, client.ApiPath.VolumeWithID(cfg.ProjectID, volumeID), volResp, nil); err != nil {
+		return diag.Errorf("Error retrieving vnpaycloud_volume %s: %s", volumeID, err)
+	}
+	if volResp.Volume.Status == "in-use" {
+		return diag.Errorf("Error reverting vnpaycloud_volume %s: the volume is attached to server %s; detach it before reverting", volumeID, volResp.Volume.AttachedServerID)
+	}
+
+	revertOpts := dto.RevertVolumeRequest{SnapshotID: snapshotID}
+
+	tflog.Debug(ctx, "vnpaycloud_volume_snapshot_revert create options", map[string]interface{}{
+		"volume_id":   volumeID,
+		"revert_opts": revertOpts,
+	})
+
+	if _, err := cfg.Client.Post(ctx, client.ApiPath.VolumeRevert(cfg.ProjectID, volumeID), revertOpts, nil, nil); err != nil {
+		return diag.Errorf("Error reverting vnpaycloud_volume %s to snapshot %s: %s", volumeID, snapshotID, err)
+	}
+
+	d.SetId(id.UniqueId())
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"reverting"},
+		Target:     []string{"active", "available"},
+		Refresh:    volumeStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, volumeID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("Error waiting for vnpaycloud_volume %s to finish reverting to snapshot %s: %s", volumeID, snapshotID, err)
+	}
+
+	return resourceVolumeSnapshotRevertRead(ctx, d, meta)
+}
+
+func resourceVolumeSnapshotRevertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	volResp := &dto.VolumeResponse{}
+	_, err := cfg.Client.Get(ctx, client.ApiPath.VolumeWithID(cfg.ProjectID, d.Get("volume_id").(string)), volResp, nil)
+	if err != nil {
+		return diag.FromErr(util.CheckNotFound(d, err, "Error retrieving vnpaycloud_volume for vnpaycloud_volume_snapshot_revert"))
+	}
+
+	d.Set("volume_status", volResp.Volume.Status)
+
+	return nil
+}
+
+func resourceVolumeSnapshotRevertDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// A revert cannot be undone; destroying the resource only forgets it.
+	return nil
+}
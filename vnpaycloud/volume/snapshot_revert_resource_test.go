@@ -0,0 +1,116 @@
+package volume
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testRevertRoutes(t *testing.T, snap dto.Snapshot, vol dto.Volume, revertBody *dto.RevertVolumeRequest) []testhelpers.Route {
+	return []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/snapshots/snap-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.SnapshotResponse{Snapshot: snap}),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: vol}),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001/revert",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(revertBody); err != nil {
+					t.Errorf("failed to decode revert body: %v", err)
+				}
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+	}
+}
+
+func TestResourceVolumeSnapshotRevertCreate(t *testing.T) {
+	snap := dto.Snapshot{ID: "snap-001", VolumeID: "vol-001", Status: "available"}
+	vol := dto.Volume{ID: "vol-001", Status: "available"}
+
+	var revertBody dto.RevertVolumeRequest
+	srv := testhelpers.NewMockServer(t, testRevertRoutes(t, snap, vol, &revertBody))
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceVolumeSnapshotRevert()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"volume_id":   "vol-001",
+		"snapshot_id": "snap-001",
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() == "" {
+		t.Error("expected an ID to be set")
+	}
+	if revertBody.SnapshotID != "snap-001" {
+		t.Errorf("expected revert to snap-001, got %q", revertBody.SnapshotID)
+	}
+	if v := d.Get("volume_status").(string); v != "available" {
+		t.Errorf("expected volume_status available, got %s", v)
+	}
+}
+
+func TestResourceVolumeSnapshotRevertCreate_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		snap    dto.Snapshot
+		vol     dto.Volume
+		wantErr string
+	}{
+		{
+			name:    "snapshot of another volume",
+			snap:    dto.Snapshot{ID: "snap-001", VolumeID: "vol-002", Status: "available"},
+			vol:     dto.Volume{ID: "vol-001", Status: "available"},
+			wantErr: "was taken from volume vol-002",
+		},
+		{
+			name:    "attached volume",
+			snap:    dto.Snapshot{ID: "snap-001", VolumeID: "vol-001", Status: "available"},
+			vol:     dto.Volume{ID: "vol-001", Status: "in-use", AttachedServerID: "inst-001"},
+			wantErr: "detach it before reverting",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var revertBody dto.RevertVolumeRequest
+			srv := testhelpers.NewMockServer(t, testRevertRoutes(t, tt.snap, tt.vol, &revertBody))
+			cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+			res := ResourceVolumeSnapshotRevert()
+			d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+				"volume_id":   "vol-001",
+				"snapshot_id": "snap-001",
+			})
+
+			diags := res.CreateContext(context.Background(), d, cfg)
+			if !diags.HasError() {
+				t.Fatal("expected error, got none")
+			}
+			if !strings.Contains(diags[0].Summary, tt.wantErr) {
+				t.Errorf("expected error containing %q, got %q", tt.wantErr, diags[0].Summary)
+			}
+			if revertBody.SnapshotID != "" {
+				t.Error("expected no revert request")
+			}
+		})
+	}
+}
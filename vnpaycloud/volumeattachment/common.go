@@ -0,0 +1,26 @@
+package volumeattachment
+
+import (
+	"fmt"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+)
+
+// serverMutexKey returns the MutexKV key used to serialize attach and detach
+// calls against a single server, so concurrent attachments do not race for
+// the next free device name.
+func serverMutexKey(serverID string) string {
+	return "vnpaycloud_instance/" + serverID
+}
+
+// checkVolumeAttachable returns an error when vol cannot be attached to
+// serverID because it is already attached elsewhere and is not multiattach.
+func checkVolumeAttachable(vol dto.Volume, serverID string) error {
+	if vol.AttachedServerID == "" || vol.IsMultiattach {
+		return nil
+	}
+
+	if vol.AttachedServerID == serverID {
+		return fmt.Errorf("volume %s is already attached to server %s; import the existing attachment instead", vol.ID, serverID)
+	}
+	return fmt.Errorf("volume %s is already attached to server %s and is not multiattach; detach it first or use a volume with multiattach = true", vol.ID, vol.AttachedServerID)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceVolumeAttachment() *schema.Resource {
@@ -21,6 +23,7 @@ func ResourceVolumeAttachment() *schema.Resource {
 		CreateContext: resourceVolumeAttachmentCreate,
 		ReadContext:   resourceVolumeAttachmentRead,
 		DeleteContext: resourceVolumeAttachmentDelete,
+		CustomizeDiff: resourceVolumeAttachmentCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -41,7 +44,11 @@ func ResourceVolumeAttachment() *schema.Resource {
 			},
 			"device": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^/dev/[a-z]+$`),
+					"must be a device path such as /dev/vdc"),
 			},
 			"status": {
 				Type:     schema.TypeString,
@@ -61,13 +68,26 @@ func resourceVolumeAttachmentCreate(ctx context.Context, d *schema.ResourceData,
 	volumeID := d.Get("volume_id").(string)
 	serverID := d.Get("server_id").(string)
 
+	cfg.MutexKV.Lock(serverMutexKey(serverID))
+	defer cfg.MutexKV.Unlock(serverMutexKey(serverID))
+
+	// The volume may have been attached since the plan was made.
+	volResp := &dto.VolumeResponse{}
+	if _, err := cfg.Client.Get(ctx, client.ApiPath.VolumeWithID(cfg.ProjectID, volumeID), volResp, nil); err != nil {
+		return diag.Errorf("Error retrieving volume %s: %s", volumeID, err)
+	}
+	if err := checkVolumeAttachable(volResp.Volume, serverID); err != nil {
+		return diag.Errorf("Error attaching volume %s to server %s: %s", volumeID, serverID, err)
+	}
+
 	attachOpts := dto.AttachVolumeRequest{
 		ServerID: serverID,
+		Device:   d.Get("device").(string),
 	}
 
 	tflog.Debug(ctx, "vnpaycloud_volume_attachment create options", map[string]interface{}{
-		"volume_id": volumeID,
-		"server_id": serverID,
+		"volume_id":   volumeID,
+		"attach_opts": attachOpts,
 	})
 
 	attachResp := &dto.VolumeAttachmentResponse{}
@@ -115,12 +135,36 @@ func resourceVolumeAttachmentRead(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+func resourceVolumeAttachmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only a new attachment can conflict; the volume and server of an
+	// existing one are ForceNew.
+	if d.Id() != "" || !d.NewValueKnown("volume_id") || !d.NewValueKnown("server_id") {
+		return nil
+	}
+
+	cfg := meta.(*config.Config)
+	volumeID := d.Get("volume_id").(string)
+
+	volResp := &dto.VolumeResponse{}
+	if _, err := cfg.Client.Get(ctx, client.ApiPath.VolumeWithID(cfg.ProjectID, volumeID), volResp, nil); err != nil {
+		if util.ResponseCodeIs(err, http.StatusNotFound) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving volume %s: %s", volumeID, err)
+	}
+
+	return checkVolumeAttachable(volResp.Volume, d.Get("server_id").(string))
+}
+
 func resourceVolumeAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
 	volumeID := d.Get("volume_id").(string)
 	serverID := d.Get("server_id").(string)
 
+	cfg.MutexKV.Lock(serverMutexKey(serverID))
+	defer cfg.MutexKV.Unlock(serverMutexKey(serverID))
+
 	detachOpts := dto.DetachVolumeRequest{
 		ServerID: serverID,
 	}
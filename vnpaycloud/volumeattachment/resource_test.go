@@ -2,13 +2,16 @@ package volumeattachment
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"strings"
 	"testing"
 
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 // testVolumeAttachment returns a fully populated dto.VolumeAttachment for use in tests.
@@ -29,6 +32,11 @@ func TestResourceVolumeAttachmentCreate(t *testing.T) {
 	att := testVolumeAttachment()
 
 	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: dto.Volume{ID: "vol-001", Status: "available"}}),
+		},
 		{
 			Method:  "POST",
 			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001/attach",
@@ -177,3 +185,139 @@ func TestResourceVolumeAttachmentDelete(t *testing.T) {
 		t.Error("expected detach POST to have been called")
 	}
 }
+
+func TestResourceVolumeAttachmentCreate_Device(t *testing.T) {
+	att := testVolumeAttachment()
+	att.Device = "/dev/vdc"
+
+	var attachBody dto.AttachVolumeRequest
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: dto.Volume{ID: "vol-001", Status: "available"}}),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001/attach",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&attachBody); err != nil {
+					t.Errorf("failed to decode attach body: %v", err)
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeAttachmentResponse{Attachment: att})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volume-attachments/att-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeAttachmentResponse{Attachment: att}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceVolumeAttachment()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"volume_id": "vol-001",
+		"server_id": "srv-001",
+		"device":    "/dev/vdc",
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if attachBody.Device != "/dev/vdc" {
+		t.Errorf("expected requested device /dev/vdc, got %q", attachBody.Device)
+	}
+	if v := d.Get("device").(string); v != "/dev/vdc" {
+		t.Errorf("expected device /dev/vdc, got %s", v)
+	}
+}
+
+func TestResourceVolumeAttachmentCreate_AlreadyAttached(t *testing.T) {
+	attachCalled := false
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: dto.Volume{ID: "vol-001", Status: "in-use", AttachedServerID: "srv-other"}}),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001/attach",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				attachCalled = true
+				w.WriteHeader(http.StatusOK)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceVolumeAttachment()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"volume_id": "vol-001",
+		"server_id": "srv-001",
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if !diags.HasError() {
+		t.Fatal("expected error for a volume attached elsewhere, got none")
+	}
+	if attachCalled {
+		t.Error("expected no attach request")
+	}
+}
+
+func TestResourceVolumeAttachmentCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		name    string
+		volume  dto.Volume
+		wantErr string
+	}{
+		{
+			name:   "detached volume",
+			volume: dto.Volume{ID: "vol-001", Status: "available"},
+		},
+		{
+			name:   "multiattach volume attached elsewhere",
+			volume: dto.Volume{ID: "vol-001", Status: "in-use", IsMultiattach: true, AttachedServerID: "srv-other"},
+		},
+		{
+			name:    "volume attached elsewhere",
+			volume:  dto.Volume{ID: "vol-001", Status: "in-use", AttachedServerID: "srv-other"},
+			wantErr: "is not multiattach",
+		},
+		{
+			name:    "volume attached to the same server",
+			volume:  dto.Volume{ID: "vol-001", Status: "in-use", AttachedServerID: "srv-001"},
+			wantErr: "already attached to server srv-001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+				{
+					Method:  "GET",
+					Pattern: "/v2/iac/projects/test-project-id/volumes/vol-001",
+					Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: tt.volume}),
+				},
+			})
+			cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+			res := ResourceVolumeAttachment()
+			raw := map[string]interface{}{"volume_id": "vol-001", "server_id": "srv-001"}
+			_, err := res.Diff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(raw), cfg)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
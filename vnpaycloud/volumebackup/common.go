@@ -0,0 +1,146 @@
+package volumebackup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+// Backup modes accepted by vnpaycloud_volume_backup.
+const (
+	backupModeFull        = "full"
+	backupModeIncremental = "incremental"
+)
+
+func volumeBackupStateRefreshFunc(ctx context.Context, c *client.Client, projectID, backupID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		backupResp := &dto.VolumeBackupResponse{}
+		_, err := c.Get(ctx, client.ApiPath.VolumeBackupWithID(projectID, backupID), backupResp, nil)
+
+		if err != nil {
+			if util.ResponseCodeIs(err, http.StatusNotFound) {
+				return backupResp.Backup, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if backupResp.Backup.Status == "failed" || backupResp.Backup.Status == "error" {
+			return backupResp.Backup, backupResp.Backup.Status, fmt.Errorf("The volume backup is in error status. " +
+				"Please check with your cloud admin or check the API logs.")
+		}
+
+		return backupResp.Backup, backupResp.Backup.Status, nil
+	}
+}
+
+// restoreTargetStateRefreshFunc reports the volume being restored as
+// restoring until it is available again after the restore started. The
+// volume is still available when the restore is accepted and may pass
+// through several transitional statuses, so any status other than available
+// or an error marks the restore as started.
+func restoreTargetStateRefreshFunc(ctx context.Context, c *client.Client, projectID, volumeID string, started *bool) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		volResp := &dto.VolumeResponse{}
+		if _, err := c.Get(ctx, client.ApiPath.VolumeWithID(projectID, volumeID), volResp, nil); err != nil {
+			return nil, "", err
+		}
+
+		switch volResp.Volume.Status {
+		case "error", "error_restoring":
+			return volResp.Volume, volResp.Volume.Status, fmt.Errorf("The volume is in error status. " +
+				"Please check with your cloud admin or check the API logs.")
+		case "available":
+			if *started {
+				return volResp.Volume, "available", nil
+			}
+		default:
+			*started = true
+		}
+
+		return volResp.Volume, "restoring", nil
+	}
+}
+
+// restoreVolumeBackup overwrites volumeID with the content of the backup and
+// waits for both to become available again. The volume must be detached and
+// at least as large as the backup.
+func restoreVolumeBackup(ctx context.Context, c *client.Client, projectID string, backup dto.VolumeBackup, volumeID string, timeout time.Duration) error {
+	volResp := &dto.VolumeResponse{}
+	if _, err := c.Get(ctx, client.ApiPath.VolumeWithID(projectID, volumeID), volResp, nil); err != nil {
+		return fmt.Errorf("error retrieving volume %s: %s", volumeID, err)
+	}
+	if volResp.Volume.Status == "in-use" {
+		return fmt.Errorf("volume %s is attached to server %s; detach it before restoring", volumeID, volResp.Volume.AttachedServerID)
+	}
+	if volResp.Volume.SizeGB < backup.SizeGB {
+		return fmt.Errorf("volume %s (%d GB) is smaller than the backup (%d GB)", volumeID, volResp.Volume.SizeGB, backup.SizeGB)
+	}
+
+	restoreOpts := dto.RestoreVolumeBackupRequest{VolumeID: volumeID}
+
+	tflog.Debug(ctx, "vnpaycloud_volume_backup restore options", map[string]interface{}{
+		"backup_id":    backup.ID,
+		"restore_opts": restoreOpts,
+	})
+
+	if _, err := c.Post(ctx, client.ApiPath.VolumeBackupRestore(projectID, backup.ID), restoreOpts, nil, nil); err != nil {
+		return err
+	}
+
+	// The backup only reports restoring while the restore runs, which also
+	// tells the volume wait below that the restore has started.
+	restoreStarted := false
+	backupRefresh := volumeBackupStateRefreshFunc(ctx, c, projectID, backup.ID)
+	backupConf := &retry.StateChangeConf{
+		Pending: []string{"restoring"},
+		Target:  []string{"available"},
+		Refresh: func() (interface{}, string, error) {
+			b, state, err := backupRefresh()
+			if state == "restoring" {
+				restoreStarted = true
+			}
+			return b, state, err
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := backupConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the backup to finish restoring: %s", err)
+	}
+
+	volumeConf := &retry.StateChangeConf{
+		Pending:    []string{"restoring"},
+		Target:     []string{"available"},
+		Refresh:    restoreTargetStateRefreshFunc(ctx, c, projectID, volumeID, &restoreStarted),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := volumeConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for volume %s to finish restoring: %s", volumeID, err)
+	}
+
+	return nil
+}
+
+func volumeBackupMode(backup dto.VolumeBackup) string {
+	if backup.IsIncremental {
+		return backupModeIncremental
+	}
+	return backupModeFull
+}
+
+// sortVolumeBackups orders backups newest first. Timestamps are ISO 8601 in
+// UTC, so they sort lexically.
+func sortVolumeBackups(backups []dto.VolumeBackup) {
+	sort.SliceStable(backups, func(i, j int) bool { return backups[i].CreatedAt > backups[j].CreatedAt })
+}
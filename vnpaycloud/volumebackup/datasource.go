@@ -0,0 +1,194 @@
+package volumebackup
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceVolumeBackup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVolumeBackupRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"most_recent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_dependent_backups": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceVolumeBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	if id, ok := d.GetOk("id"); ok && id.(string) != "" {
+		backupResp := &dto.VolumeBackupResponse{}
+		_, err := cfg.Client.Get(ctx, client.ApiPath.VolumeBackupWithID(cfg.ProjectID, id.(string)), backupResp, nil)
+		if err != nil {
+			return diag.Errorf("Error retrieving vnpaycloud_volume_backup %s: %s", id, err)
+		}
+		setVolumeBackupDataSourceAttributes(d, backupResp.Backup)
+		return nil
+	}
+
+	backups, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VolumeBackups(cfg.ProjectID), func(r *dto.ListVolumeBackupsResponse) []dto.VolumeBackup { return r.Backups })
+	if err != nil {
+		return diag.Errorf("Unable to query vnpaycloud_volume_backup: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	volumeID := d.Get("volume_id").(string)
+	var matched []dto.VolumeBackup
+	for _, backup := range backups {
+		if name != "" && backup.Name != name {
+			continue
+		}
+		if volumeID != "" && backup.VolumeID != volumeID {
+			continue
+		}
+		matched = append(matched, backup)
+	}
+
+	if len(matched) < 1 {
+		return diag.Errorf("Your vnpaycloud_volume_backup query returned no results")
+	}
+
+	if len(matched) > 1 {
+		if !d.Get("most_recent").(bool) {
+			return diag.Errorf("Your vnpaycloud_volume_backup query returned multiple results; set most_recent = true or narrow the query")
+		}
+		sortVolumeBackups(matched)
+	}
+
+	tflog.Debug(ctx, "Retrieved vnpaycloud_volume_backup datasource", map[string]interface{}{"backup": matched[0]})
+	setVolumeBackupDataSourceAttributes(d, matched[0])
+
+	return nil
+}
+
+func setVolumeBackupDataSourceAttributes(d *schema.ResourceData, backup dto.VolumeBackup) {
+	d.SetId(backup.ID)
+	d.Set("name", backup.Name)
+	d.Set("volume_id", backup.VolumeID)
+	d.Set("description", backup.Description)
+	d.Set("mode", volumeBackupMode(backup))
+	d.Set("size", backup.SizeGB)
+	d.Set("status", backup.Status)
+	d.Set("has_dependent_backups", backup.HasDependentBackups)
+	d.Set("created_at", backup.CreatedAt)
+}
+
+func DataSourceVolumeBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceVolumeBackupsRead,
+		Schema: map[string]*schema.Schema{
+			"volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id":          {Type: schema.TypeString, Computed: true},
+						"name":        {Type: schema.TypeString, Computed: true},
+						"description": {Type: schema.TypeString, Computed: true},
+						"volume_id":   {Type: schema.TypeString, Computed: true},
+						"mode":        {Type: schema.TypeString, Computed: true},
+						"size":        {Type: schema.TypeInt, Computed: true},
+						"status":      {Type: schema.TypeString, Computed: true},
+						"created_at":  {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVolumeBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	allBackups, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VolumeBackups(cfg.ProjectID), func(r *dto.ListVolumeBackupsResponse) []dto.VolumeBackup { return r.Backups })
+	if err != nil {
+		return diag.Errorf("Error listing vnpaycloud_volume_backups: %s", err)
+	}
+
+	allBackups, err = util.ApplyDataSourceFilters(d, allBackups, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_volume_backups: %s", err)
+	}
+	sortVolumeBackups(allBackups)
+
+	volumeID := d.Get("volume_id").(string)
+	var backups []map[string]interface{}
+	for _, backup := range allBackups {
+		if volumeID != "" && backup.VolumeID != volumeID {
+			continue
+		}
+		backups = append(backups, map[string]interface{}{
+			"id":          backup.ID,
+			"name":        backup.Name,
+			"description": backup.Description,
+			"volume_id":   backup.VolumeID,
+			"mode":        volumeBackupMode(backup),
+			"size":        backup.SizeGB,
+			"status":      backup.Status,
+			"created_at":  backup.CreatedAt,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("volume-backups-%s-%s", cfg.ProjectID, volumeID))
+	d.Set("backups", backups)
+
+	return nil
+}
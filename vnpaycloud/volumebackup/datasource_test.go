@@ -0,0 +1,76 @@
+package volumebackup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testVolumeBackupListRoute(t *testing.T) testhelpers.Route {
+	return testhelpers.Route{
+		Method:  "GET",
+		Pattern: "/v2/iac/projects/test-project-id/volume-backups",
+		Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.ListVolumeBackupsResponse{Backups: []dto.VolumeBackup{
+			{ID: "bak-001", Name: "db-full", VolumeID: "vol-001", SizeGB: 50, Status: "available", CreatedAt: "2025-01-13T10:00:00Z"},
+			{ID: "bak-002", Name: "db-incr", VolumeID: "vol-001", IsIncremental: true, SizeGB: 50, Status: "available", CreatedAt: "2025-01-15T10:00:00Z"},
+			{ID: "bak-003", Name: "logs-full", VolumeID: "vol-002", SizeGB: 20, Status: "available", CreatedAt: "2025-01-16T10:00:00Z"},
+		}}),
+	}
+}
+
+func TestDataSourceVolumeBackupsRead_ByVolume(t *testing.T) {
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{testVolumeBackupListRoute(t)})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	ds := DataSourceVolumeBackups()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"volume_id": "vol-001",
+	})
+
+	diags := ds.ReadContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	backups := d.Get("backups").([]interface{})
+	if len(backups) != 2 {
+		t.Fatalf("expected 2 backups, got %d", len(backups))
+	}
+	latest := backups[0].(map[string]interface{})
+	if latest["id"] != "bak-002" || latest["mode"] != "incremental" || latest["size"] != 50 {
+		t.Errorf("expected the incremental backup bak-002 first, got %v", latest)
+	}
+	if latest["created_at"] != "2025-01-15T10:00:00Z" {
+		t.Errorf("expected created_at 2025-01-15T10:00:00Z, got %v", latest["created_at"])
+	}
+}
+
+func TestDataSourceVolumeBackupRead_MostRecent(t *testing.T) {
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{testVolumeBackupListRoute(t)})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	ds := DataSourceVolumeBackup()
+
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"volume_id": "vol-001",
+	})
+	if diags := ds.ReadContext(context.Background(), d, cfg); !diags.HasError() {
+		t.Fatal("expected error for multiple results without most_recent, got none")
+	}
+
+	d = schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"volume_id":   "vol-001",
+		"most_recent": true,
+	})
+	if diags := ds.ReadContext(context.Background(), d, cfg); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Id() != "bak-002" {
+		t.Errorf("expected the most recent backup bak-002, got %s", d.Id())
+	}
+}
@@ -0,0 +1,211 @@
+package volumebackup
+
+import (
+	"context"
+	"terraform-provider-vnpaycloud/vnpaycloud/config"
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceVolumeBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceVolumeBackupCreate,
+		ReadContext:   resourceVolumeBackupRead,
+		UpdateContext: resourceVolumeBackupUpdate,
+		DeleteContext: resourceVolumeBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVolumeBackupImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"volume_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      backupModeFull,
+				ValidateFunc: validation.StringInSlice([]string{backupModeFull, backupModeIncremental}, false),
+			},
+			"force": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"restore_to_volume_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"has_dependent_backups": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceVolumeBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	createOpts := dto.CreateVolumeBackupRequest{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		VolumeID:    d.Get("volume_id").(string),
+		Incremental: d.Get("mode").(string) == backupModeIncremental,
+		Force:       d.Get("force").(bool),
+	}
+
+	tflog.Debug(ctx, "vnpaycloud_volume_backup create options", map[string]interface{}{"create_opts": createOpts})
+
+	createResp := &dto.VolumeBackupResponse{}
+	_, err := cfg.Client.Post(ctx, client.ApiPath.VolumeBackups(cfg.ProjectID), createOpts, createResp, nil)
+	if err != nil {
+		return diag.Errorf("Error creating vnpaycloud_volume_backup: %s", err)
+	}
+
+	d.SetId(createResp.Backup.ID)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"initiating", "creating"},
+		Target:     []string{"available"},
+		Refresh:    volumeBackupStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, createResp.Backup.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	backupRaw, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for vnpaycloud_volume_backup %s to become ready: %s", createResp.Backup.ID, err)
+	}
+
+	if volumeID := d.Get("restore_to_volume_id").(string); volumeID != "" {
+		if err := restoreVolumeBackup(ctx, cfg.Client, cfg.ProjectID, backupRaw.(dto.VolumeBackup), volumeID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.Errorf("Error restoring vnpaycloud_volume_backup %s to volume %s: %s", d.Id(), volumeID, err)
+		}
+	}
+
+	return resourceVolumeBackupRead(ctx, d, meta)
+}
+
+func resourceVolumeBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	backupResp := &dto.VolumeBackupResponse{}
+	_, err := cfg.Client.Get(ctx, client.ApiPath.VolumeBackupWithID(cfg.ProjectID, d.Id()), backupResp, nil)
+	if err != nil {
+		return diag.FromErr(util.CheckNotFound(d, err, "Error retrieving vnpaycloud_volume_backup"))
+	}
+
+	tflog.Debug(ctx, "Retrieved vnpaycloud_volume_backup "+d.Id(), map[string]interface{}{"backup": backupResp.Backup})
+
+	d.Set("name", backupResp.Backup.Name)
+	d.Set("description", backupResp.Backup.Description)
+	d.Set("volume_id", backupResp.Backup.VolumeID)
+	d.Set("mode", volumeBackupMode(backupResp.Backup))
+	d.Set("size", backupResp.Backup.SizeGB)
+	d.Set("status", backupResp.Backup.Status)
+	d.Set("has_dependent_backups", backupResp.Backup.HasDependentBackups)
+	d.Set("created_at", backupResp.Backup.CreatedAt)
+
+	return nil
+}
+
+// resourceVolumeBackupImport sets force to its default, since the API does
+// not return it and a missing value would plan a replacement.
+func resourceVolumeBackupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("force", false)
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVolumeBackupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	// Clearing restore_to_volume_id leaves the restored volume as it is.
+	if volumeID := d.Get("restore_to_volume_id").(string); d.HasChange("restore_to_volume_id") && volumeID != "" {
+		backupResp := &dto.VolumeBackupResponse{}
+		if _, err := cfg.Client.Get(ctx, client.ApiPath.VolumeBackupWithID(cfg.ProjectID, d.Id()), backupResp, nil); err != nil {
+			return diag.Errorf("Error retrieving vnpaycloud_volume_backup %s: %s", d.Id(), err)
+		}
+
+		if err := restoreVolumeBackup(ctx, cfg.Client, cfg.ProjectID, backupResp.Backup, volumeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.Errorf("Error restoring vnpaycloud_volume_backup %s to volume %s: %s", d.Id(), volumeID, err)
+		}
+	}
+
+	return resourceVolumeBackupRead(ctx, d, meta)
+}
+
+func resourceVolumeBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+
+	backupResp := &dto.VolumeBackupResponse{}
+	_, err := cfg.Client.Get(ctx, client.ApiPath.VolumeBackupWithID(cfg.ProjectID, d.Id()), backupResp, nil)
+	if err != nil {
+		return diag.FromErr(util.CheckDeleted(d, err, "Error retrieving vnpaycloud_volume_backup"))
+	}
+
+	if backupResp.Backup.HasDependentBackups {
+		return diag.Errorf("Error deleting vnpaycloud_volume_backup %s: incremental backups depend on it; delete them first", d.Id())
+	}
+
+	if backupResp.Backup.Status != "deleting" {
+		if _, err := cfg.Client.Delete(ctx, client.ApiPath.VolumeBackupWithID(cfg.ProjectID, d.Id()), nil); err != nil {
+			return diag.FromErr(util.CheckDeleted(d, err, "Error deleting vnpaycloud_volume_backup"))
+		}
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    volumeBackupStateRefreshFunc(ctx, cfg.Client, cfg.ProjectID, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return diag.Errorf("Error waiting for vnpaycloud_volume_backup %s to delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package volumebackup
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"terraform-provider-vnpaycloud/vnpaycloud/dto"
+	"terraform-provider-vnpaycloud/vnpaycloud/helper/testhelpers"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// testVolumeBackup returns a fully populated dto.VolumeBackup for use in tests.
+func testVolumeBackup() dto.VolumeBackup {
+	return dto.VolumeBackup{
+		ID:          "bak-001",
+		Name:        "db-full",
+		Description: "full backup",
+		VolumeID:    "vol-001",
+		SizeGB:      50,
+		Status:      "available",
+		CreatedAt:   "2025-01-15T10:00:00Z",
+		ProjectID:   testhelpers.TestProjectID,
+		ZoneID:      testhelpers.TestZoneID,
+	}
+}
+
+func TestResourceVolumeBackupCreate_Incremental(t *testing.T) {
+	backup := testVolumeBackup()
+	backup.IsIncremental = true
+
+	var createBody dto.CreateVolumeBackupRequest
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volume-backups",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&createBody); err != nil {
+					t.Errorf("failed to decode create body: %v", err)
+				}
+				creating := backup
+				creating.Status = "creating"
+				testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeBackupResponse{Backup: creating})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volume-backups/bak-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeBackupResponse{Backup: backup}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceVolumeBackup()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":      "db-full",
+		"volume_id": "vol-001",
+		"mode":      "incremental",
+		"force":     true,
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "bak-001" {
+		t.Errorf("expected ID bak-001, got %s", d.Id())
+	}
+	if !createBody.Incremental || !createBody.Force {
+		t.Errorf("expected an incremental forced backup, got %+v", createBody)
+	}
+	if v := d.Get("mode").(string); v != "incremental" {
+		t.Errorf("expected mode incremental, got %s", v)
+	}
+	if v := d.Get("size").(int); v != 50 {
+		t.Errorf("expected size 50, got %d", v)
+	}
+}
+
+func TestResourceVolumeBackupUpdate_Restore(t *testing.T) {
+	backup := testVolumeBackup()
+
+	var restoreBody dto.RestoreVolumeBackupRequest
+	var restoring atomic.Bool
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volume-backups/bak-001",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				b := backup
+				if restoring.Swap(false) {
+					b.Status = "restoring"
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeBackupResponse{Backup: b})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-restore",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: dto.Volume{ID: "vol-restore", SizeGB: 100, Status: "available"}}),
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volume-backups/bak-001/restore",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if err := json.NewDecoder(r.Body).Decode(&restoreBody); err != nil {
+					t.Errorf("failed to decode restore body: %v", err)
+				}
+				restoring.Store(true)
+				w.WriteHeader(http.StatusAccepted)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceVolumeBackup()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":                 "db-full",
+		"volume_id":            "vol-001",
+		"restore_to_volume_id": "vol-restore",
+	})
+	d.SetId("bak-001")
+
+	diags := res.UpdateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if restoreBody.VolumeID != "vol-restore" {
+		t.Errorf("expected restore to vol-restore, got %q", restoreBody.VolumeID)
+	}
+}
+
+func TestRestoreVolumeBackup_WaitsForVolume(t *testing.T) {
+	// The volume is still available when the restore is accepted, then
+	// passes through transitional statuses before it is restored.
+	statuses := []string{"available", "available", "downloading", "restoring-backup", "available"}
+	var mu sync.Mutex
+	var gets int
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volume-backups/bak-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeBackupResponse{Backup: testVolumeBackup()}),
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/volumes/vol-restore",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				status := statuses[min(gets, len(statuses)-1)]
+				gets++
+				mu.Unlock()
+				testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: dto.Volume{ID: "vol-restore", SizeGB: 100, Status: status}})(w, r)
+			},
+		},
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/volume-backups/bak-001/restore",
+			Handler: testhelpers.EmptyHandler(http.StatusAccepted),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	if err := restoreVolumeBackup(context.Background(), cfg.Client, cfg.ProjectID, testVolumeBackup(), "vol-restore", time.Minute); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if gets != len(statuses) {
+		t.Errorf("expected %d volume reads before the restore finished, got %d", len(statuses), gets)
+	}
+}
+
+func TestRestoreVolumeBackup_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		volume  dto.Volume
+		wantErr string
+	}{
+		{
+			name:    "attached volume",
+			volume:  dto.Volume{ID: "vol-restore", SizeGB: 100, Status: "in-use", AttachedServerID: "inst-001"},
+			wantErr: "detach it before restoring",
+		},
+		{
+			name:    "volume smaller than the backup",
+			volume:  dto.Volume{ID: "vol-restore", SizeGB: 20, Status: "available"},
+			wantErr: "is smaller than the backup",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restoreCalled := false
+			srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+				{
+					Method:  "GET",
+					Pattern: "/v2/iac/projects/test-project-id/volumes/vol-restore",
+					Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeResponse{Volume: tt.volume}),
+				},
+				{
+					Method:  "POST",
+					Pattern: "/v2/iac/projects/test-project-id/volume-backups/bak-001/restore",
+					Handler: func(w http.ResponseWriter, r *http.Request) {
+						restoreCalled = true
+						w.WriteHeader(http.StatusAccepted)
+					},
+				},
+			})
+			cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+			err := restoreVolumeBackup(context.Background(), cfg.Client, cfg.ProjectID, testVolumeBackup(), "vol-restore", time.Minute)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if restoreCalled {
+				t.Error("expected no restore request")
+			}
+		})
+	}
+}
+
+func TestResourceVolumeBackupDelete_DependentBackups(t *testing.T) {
+	backup := testVolumeBackup()
+	backup.HasDependentBackups = true
+
+	deleteCalled := false
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Pattern: "/v2/iac/projects/test-project-id/volume-backups/bak-001",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == "DELETE" {
+					deleteCalled = true
+					w.WriteHeader(http.StatusAccepted)
+					return
+				}
+				testhelpers.JSONHandler(t, http.StatusOK, dto.VolumeBackupResponse{Backup: backup})(w, r)
+			},
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+
+	res := ResourceVolumeBackup()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":      "db-full",
+		"volume_id": "vol-001",
+	})
+	d.SetId("bak-001")
+
+	diags := res.DeleteContext(context.Background(), d, cfg)
+	if !diags.HasError() {
+		t.Fatal("expected error for a backup with dependent backups, got none")
+	}
+	if deleteCalled {
+		t.Error("expected no DELETE request")
+	}
+}
+
+// TestResourceVolumeBackupImport_Force verifies that import records force,
+// which the API does not return, so the next plan does not replace the backup.
+func TestResourceVolumeBackupImport_Force(t *testing.T) {
+	res := ResourceVolumeBackup()
+	d := res.TestResourceData()
+	d.SetId("bak-001")
+
+	imported, err := res.Importer.StateContext(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("unexpected import error: %v", err)
+	}
+	if len(imported) != 1 {
+		t.Fatalf("expected 1 imported resource, got %d", len(imported))
+	}
+	if v := imported[0].State().Attributes["force"]; v != "false" {
+		t.Errorf("expected force false in imported state, got %q", v)
+	}
+}
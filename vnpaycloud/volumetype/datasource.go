@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -57,15 +58,14 @@ func dataSourceVolumeTypeRead(ctx context.Context, d *schema.ResourceData, meta
 		return setVolumeTypeData(d, &resp.VolumeType)
 	}
 
-	listResp := &dto.ListVolumeTypesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VolumeTypes(cfg.ZoneID), listResp, nil)
+	volumeTypes, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VolumeTypes(cfg.ZoneID), func(r *dto.ListVolumeTypesResponse) []dto.VolumeType { return r.VolumeTypes })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_volume_type: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, vt := range listResp.VolumeTypes {
+	for _, vt := range volumeTypes {
 		if nameOk && vt.Name != nameFilter.(string) {
 			continue
 		}
@@ -89,6 +89,8 @@ func DataSourceVolumeTypes() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVolumeTypesRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"volume_types": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -110,14 +112,18 @@ func DataSourceVolumeTypes() *schema.Resource {
 func dataSourceVolumeTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListVolumeTypesResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VolumeTypes(cfg.ZoneID), listResp, nil)
+	allVolumeTypes, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VolumeTypes(cfg.ZoneID), func(r *dto.ListVolumeTypesResponse) []dto.VolumeType { return r.VolumeTypes })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_volume_types: %s", err)
 	}
 
+	allVolumeTypes, err = util.ApplyDataSourceFilters(d, allVolumeTypes, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_volume_types: %s", err)
+	}
+
 	var volumeTypes []map[string]interface{}
-	for _, vt := range listResp.VolumeTypes {
+	for _, vt := range allVolumeTypes {
 		volumeTypes = append(volumeTypes, map[string]interface{}{
 			"id":             vt.ID,
 			"name":           vt.Name,
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -74,15 +75,14 @@ func dataSourceVpcRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	}
 
 	// Otherwise, list and filter by name
-	listResp := &dto.ListVPCsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VPCs(cfg.ProjectID), listResp, nil)
+	vpcs, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VPCs(cfg.ProjectID), func(r *dto.ListVPCsResponse) []dto.VPC { return r.VPCs })
 	if err != nil {
 		return diag.Errorf("Unable to query vnpaycloud_vpc: %s", err)
 	}
 
 	name := d.Get("name").(string)
 	var matched []dto.VPC
-	for _, v := range listResp.VPCs {
+	for _, v := range vpcs {
 		if name != "" && v.Name != name {
 			continue
 		}
@@ -119,6 +119,8 @@ func DataSourceVpcs() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVpcsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"vpcs": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -147,14 +149,18 @@ func DataSourceVpcs() *schema.Resource {
 func dataSourceVpcsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListVPCsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VPCs(cfg.ProjectID), listResp, nil)
+	allVPCs, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VPCs(cfg.ProjectID), func(r *dto.ListVPCsResponse) []dto.VPC { return r.VPCs })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_vpcs: %s", err)
 	}
 
+	allVPCs, err = util.ApplyDataSourceFilters(d, allVPCs, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_vpcs: %s", err)
+	}
+
 	var vpcs []map[string]interface{}
-	for _, v := range listResp.VPCs {
+	for _, v := range allVPCs {
 		vpcs = append(vpcs, map[string]interface{}{
 			"id":           v.ID,
 			"name":         v.Name,
@@ -23,6 +23,7 @@ func ResourceVpc() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: util.SetTagsDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
@@ -62,6 +63,8 @@ func ResourceVpc() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"tags":     util.TagsSchema(),
+			"tags_all": util.TagsAllSchema(),
 			"created_at": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -82,6 +85,7 @@ func resourceVpcCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		Name:        d.Get("name").(string),
 		Description: d.Get("description").(string),
 		CIDR:        cidr,
+		Tags:        util.GetTagsAll(d, meta),
 	}
 
 	tflog.Debug(ctx, "vnpaycloud_vpc create options", map[string]interface{}{"create_opts": createOpts})
@@ -130,6 +134,7 @@ func resourceVpcRead(ctx context.Context, d *schema.ResourceData, meta interface
 	d.Set("snat_address", vpcResp.VPC.SnatAddress)
 	d.Set("subnet_ids", vpcResp.VPC.SubnetIDs)
 	d.Set("created_at", vpcResp.VPC.CreatedAt)
+	util.SetResourceTags(d, meta, vpcResp.VPC.Tags)
 
 	return nil
 }
@@ -151,6 +156,16 @@ func resourceVpcUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		tagsOpts := dto.UpdateTagsRequest{Tags: util.GetTagsAll(d, meta)}
+
+		tflog.Debug(ctx, "vnpaycloud_vpc update tags options", map[string]interface{}{"tags_opts": tagsOpts})
+
+		if _, err := cfg.Client.Put(ctx, client.ApiPath.VPCTags(cfg.ProjectID, d.Id()), tagsOpts, nil, nil); err != nil {
+			return diag.Errorf("Error updating tags for vnpaycloud_vpc %s: %s", d.Id(), err)
+		}
+	}
+
 	return resourceVpcRead(ctx, d, meta)
 }
 
@@ -183,6 +183,56 @@ func TestResourceVpcCreate_ReadsSNATFields(t *testing.T) {
 	}
 }
 
+func TestResourceVpcCreate_MergesDefaultTags(t *testing.T) {
+	vpc := testVPC()
+	vpc.Tags = map[string]string{"env": "prod", "owner": "net"}
+
+	srv := testhelpers.NewMockServer(t, []testhelpers.Route{
+		{
+			Method:  "POST",
+			Pattern: "/v2/iac/projects/test-project-id/vpcs",
+			Handler: func(w http.ResponseWriter, r *http.Request) {
+				var req dto.CreateVPCRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Fatalf("failed to decode create request: %v", err)
+				}
+				if req.Tags["env"] != "prod" || req.Tags["owner"] != "net" || len(req.Tags) != 2 {
+					t.Errorf("expected merged tags {env:prod owner:net}, got %v", req.Tags)
+				}
+
+				testhelpers.JSONHandler(t, http.StatusOK, dto.VPCResponse{VPC: vpc})(w, r)
+			},
+		},
+		{
+			Method:  "GET",
+			Pattern: "/v2/iac/projects/test-project-id/vpcs/vpc-001",
+			Handler: testhelpers.JSONHandler(t, http.StatusOK, dto.VPCResponse{VPC: vpc}),
+		},
+	})
+	cfg := testhelpers.NewMockConfig(t, srv.URL)
+	cfg.DefaultTags = map[string]string{"env": "prod", "owner": "platform"}
+
+	res := ResourceVpc()
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "test-vpc",
+		"tags": map[string]interface{}{"owner": "net"},
+	})
+
+	diags := res.CreateContext(context.Background(), d, cfg)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	if len(tags) != 1 || tags["owner"] != "net" {
+		t.Errorf("expected tags {owner:net}, got %v", tags)
+	}
+	tagsAll := d.Get("tags_all").(map[string]interface{})
+	if len(tagsAll) != 2 || tagsAll["env"] != "prod" {
+		t.Errorf("expected tags_all {env:prod owner:net}, got %v", tagsAll)
+	}
+}
+
 func TestResourceVpcRead(t *testing.T) {
 	vpc := testVPC()
 
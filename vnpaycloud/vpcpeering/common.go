@@ -44,14 +44,13 @@ func findReversePeeringID(ctx context.Context, c *client.Client, peeringID strin
 	srcVpcID := peeringResp.PeeringConnection.SrcVpcID
 	destVpcID := peeringResp.PeeringConnection.DestVpcID
 
-	listResp := &dto.ListPeeringConnectionsResponse{}
-	_, err = c.Get(ctx, client.ApiPath.PeeringConnections(), listResp, nil)
+	peeringConnections, err := client.ListAll(ctx, c, client.ApiPath.PeeringConnections(), func(r *dto.ListPeeringConnectionsResponse) []dto.PeeringConnection { return r.PeeringConnections })
 	if err != nil {
 		tflog.Warn(ctx, "Failed to list peerings for reverse lookup", map[string]interface{}{"error": err.Error()})
 		return ""
 	}
 
-	for _, p := range listResp.PeeringConnections {
+	for _, p := range peeringConnections {
 		if p.ID != peeringID && p.SrcVpcID == destVpcID && p.DestVpcID == srcVpcID {
 			tflog.Info(ctx, "Found reverse peering", map[string]interface{}{"primary_id": peeringID, "reverse_id": p.ID})
 			return p.ID
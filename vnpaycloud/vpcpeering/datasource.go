@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -70,15 +71,14 @@ func dataSourceVPCPeeringRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// List and filter client-side
-	listResp := &dto.ListPeeringConnectionsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.PeeringConnections(), listResp, nil)
+	peeringConnections, err := client.ListAll(ctx, cfg.Client, client.ApiPath.PeeringConnections(), func(r *dto.ListPeeringConnectionsResponse) []dto.PeeringConnection { return r.PeeringConnections })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_vpc_peering: %s", err)
 	}
 
 	nameFilter, nameOk := d.GetOk("name")
 
-	for _, p := range listResp.PeeringConnections {
+	for _, p := range peeringConnections {
 		if nameOk && p.Name != nameFilter.(string) {
 			continue
 		}
@@ -105,6 +105,8 @@ func DataSourceVPCPeerings() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceVPCPeeringsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"vpc_peerings": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -129,14 +131,18 @@ func DataSourceVPCPeerings() *schema.Resource {
 func dataSourceVPCPeeringsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListPeeringConnectionsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.PeeringConnections(), listResp, nil)
+	peeringConnections, err := client.ListAll(ctx, cfg.Client, client.ApiPath.PeeringConnections(), func(r *dto.ListPeeringConnectionsResponse) []dto.PeeringConnection { return r.PeeringConnections })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_vpc_peerings: %s", err)
 	}
 
+	peeringConnections, err = util.ApplyDataSourceFilters(d, peeringConnections, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_vpc_peerings: %s", err)
+	}
+
 	var vpcPeerings []map[string]interface{}
-	for _, p := range listResp.PeeringConnections {
+	for _, p := range peeringConnections {
 		vpcPeerings = append(vpcPeerings, map[string]interface{}{
 			"id":             p.ID,
 			"name":           p.Name,
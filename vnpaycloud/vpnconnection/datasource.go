@@ -131,14 +131,13 @@ func dataSourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("One of id or name must be specified for vnpaycloud_vpn_connection")
 	}
 
-	listResp := &dto.ListVPNConnectionsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VPNConnections(cfg.ProjectID), listResp, nil)
+	vpnConnections, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VPNConnections(cfg.ProjectID), func(r *dto.ListVPNConnectionsResponse) []dto.VPNConnection { return r.VPNConnections })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_vpn_connection: %s", err)
 	}
 
 	matches := make([]dto.VPNConnection, 0, 1)
-	for _, vpnConnection := range listResp.VPNConnections {
+	for _, vpnConnection := range vpnConnections {
 		if vpnConnection.Name != nameFilter.(string) {
 			continue
 		}
@@ -178,6 +177,8 @@ func DataSourceVPNConnections() *schema.Resource {
 		ReadContext: dataSourceVPNConnectionsRead,
 		Description: "Use this data source to retrieve all VNPAY Cloud VPN connections in the current project.",
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"vpn_connections": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -279,14 +280,18 @@ func DataSourceVPNConnections() *schema.Resource {
 func dataSourceVPNConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListVPNConnectionsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VPNConnections(cfg.ProjectID), listResp, nil)
+	allVPNConnections, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VPNConnections(cfg.ProjectID), func(r *dto.ListVPNConnectionsResponse) []dto.VPNConnection { return r.VPNConnections })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_vpn_connections: %s", err)
 	}
 
-	vpnConnections := make([]map[string]interface{}, 0, len(listResp.VPNConnections))
-	for _, vpnConnection := range listResp.VPNConnections {
+	allVPNConnections, err = util.ApplyDataSourceFilters(d, allVPNConnections, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_vpn_connections: %s", err)
+	}
+
+	vpnConnections := make([]map[string]interface{}, 0, len(allVPNConnections))
+	for _, vpnConnection := range allVPNConnections {
 		vpnConnections = append(vpnConnections, map[string]interface{}{
 			"id":                    vpnConnection.ID,
 			"name":                  vpnConnection.Name,
@@ -78,14 +78,13 @@ func dataSourceVPNGatewayRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("One of id or name must be specified for vnpaycloud_vpn_gateway")
 	}
 
-	listResp := &dto.ListVPNGatewaysResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VPNGateways(cfg.ProjectID), listResp, nil)
+	vpnGateways, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VPNGateways(cfg.ProjectID), func(r *dto.ListVPNGatewaysResponse) []dto.VPNGateway { return r.VPNGateways })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_vpnaas_vpn_gateway: %s", err)
 	}
 
 	matches := make([]dto.VPNGateway, 0, 1)
-	for _, vpnGateway := range listResp.VPNGateways {
+	for _, vpnGateway := range vpnGateways {
 		if vpnGateway.Name != nameFilter.(string) {
 			continue
 		}
@@ -119,6 +118,8 @@ func DataSourceVPNGateways() *schema.Resource {
 		ReadContext: dataSourceVPNGatewaysRead,
 		Description: "Use this data source to retrieve all VNPAY Cloud VPN gateways in the current project.",
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"vpn_gateways": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -169,14 +170,18 @@ func DataSourceVPNGateways() *schema.Resource {
 func dataSourceVPNGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListVPNGatewaysResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VPNGateways(cfg.ProjectID), listResp, nil)
+	allVPNGateways, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VPNGateways(cfg.ProjectID), func(r *dto.ListVPNGatewaysResponse) []dto.VPNGateway { return r.VPNGateways })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_vpn_gateways: %s", err)
 	}
 
+	allVPNGateways, err = util.ApplyDataSourceFilters(d, allVPNGateways, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_vpn_gateways: %s", err)
+	}
+
 	var vpnGateways []map[string]interface{}
-	for _, vpnGateway := range listResp.VPNGateways {
+	for _, vpnGateway := range allVPNGateways {
 		vpnGateways = append(vpnGateways, map[string]interface{}{
 			"id":               vpnGateway.ID,
 			"name":             vpnGateway.Name,
@@ -72,14 +72,13 @@ func dataSourceVPNPublicIPRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("One of id or name must be specified for vnpaycloud_vpn_public_ip")
 	}
 
-	listResp := &dto.ListVPNPublicIPsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VPNPublicIPs(cfg.ProjectID), listResp, nil)
+	vpnPublicIPs, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VPNPublicIPs(cfg.ProjectID), func(r *dto.ListVPNPublicIPsResponse) []dto.VPNPublicIP { return r.VPNPublicIPs })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_vpn_public_ip: %s", err)
 	}
 
 	matches := make([]dto.VPNPublicIP, 0, 1)
-	for _, vpnPublicIP := range listResp.VPNPublicIPs {
+	for _, vpnPublicIP := range vpnPublicIPs {
 		if vpnPublicIP.Name != nameFilter.(string) {
 			continue
 		}
@@ -112,6 +111,8 @@ func DataSourceVPNPublicIPs() *schema.Resource {
 		ReadContext: dataSourceVPNPublicIPsRead,
 		Description: "Use this data source to retrieve all VNPAY Cloud VPN public IPs in the current project.",
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"vpn_public_ips": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -157,14 +158,18 @@ func DataSourceVPNPublicIPs() *schema.Resource {
 func dataSourceVPNPublicIPsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	cfg := meta.(*config.Config)
 
-	listResp := &dto.ListVPNPublicIPsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.VPNPublicIPs(cfg.ProjectID), listResp, nil)
+	allVPNPublicIPs, err := client.ListAll(ctx, cfg.Client, client.ApiPath.VPNPublicIPs(cfg.ProjectID), func(r *dto.ListVPNPublicIPsResponse) []dto.VPNPublicIP { return r.VPNPublicIPs })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_vpn_public_ips: %s", err)
 	}
 
+	allVPNPublicIPs, err = util.ApplyDataSourceFilters(d, allVPNPublicIPs, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_vpn_public_ips: %s", err)
+	}
+
 	var vpnPublicIPs []map[string]interface{}
-	for _, vpnPublicIP := range listResp.VPNPublicIPs {
+	for _, vpnPublicIP := range allVPNPublicIPs {
 		vpnPublicIPs = append(vpnPublicIPs, map[string]interface{}{
 			"id":          vpnPublicIP.ID,
 			"name":        vpnPublicIP.Name,
@@ -6,6 +6,7 @@ import (
 	"terraform-provider-vnpaycloud/vnpaycloud/config"
 	"terraform-provider-vnpaycloud/vnpaycloud/dto"
 	"terraform-provider-vnpaycloud/vnpaycloud/helper/client"
+	"terraform-provider-vnpaycloud/vnpaycloud/util"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -89,6 +90,8 @@ func DataSourceWorkerGroups() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceWorkerGroupsRead,
 		Schema: map[string]*schema.Schema{
+			"filter":     util.DataSourceFiltersSchema(),
+			"name_regex": util.DataSourceNameRegexSchema(),
 			"cluster_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -118,14 +121,18 @@ func dataSourceWorkerGroupsRead(ctx context.Context, d *schema.ResourceData, met
 	cfg := meta.(*config.Config)
 	clusterID := d.Get("cluster_id").(string)
 
-	listResp := &dto.ListWorkerGroupsResponse{}
-	_, err := cfg.Client.Get(ctx, client.ApiPath.WorkerGroups(cfg.ProjectID, clusterID), listResp, nil)
+	allWorkerGroups, err := client.ListAll(ctx, cfg.Client, client.ApiPath.WorkerGroups(cfg.ProjectID, clusterID), func(r *dto.ListWorkerGroupsResponse) []dto.WorkerGroup { return r.WorkerGroups })
 	if err != nil {
 		return diag.Errorf("Error listing vnpaycloud_kubernetes_worker_groups: %s", err)
 	}
 
+	allWorkerGroups, err = util.ApplyDataSourceFilters(d, allWorkerGroups, "name")
+	if err != nil {
+		return diag.Errorf("Error filtering vnpaycloud_kubernetes_worker_groups: %s", err)
+	}
+
 	var workerGroups []map[string]interface{}
-	for _, wg := range listResp.WorkerGroups {
+	for _, wg := range allWorkerGroups {
 		workerGroups = append(workerGroups, map[string]interface{}{
 			"id":           wg.ID,
 			"name":         wg.Name,